@@ -1,13 +1,22 @@
 package dc
 
-import "time"
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
 
 type Article struct {
 	ID               int64
 	Gallery          *Gallery
+	Source           *Gallery // 베스트 게시글의 원본 갤러리, 아이디를 알 수 없다면 이름만 설정됨
 	Author           *User
 	Subject          string // 제목
 	Content          string // 내용
+	Views            int    // 조회 수
 	TextComments     int    // 댓글 수
 	VoiceComments    int    // 보이스 리플 수
 	Upvotes          int    // 추천 수 (종합)
@@ -15,3 +24,149 @@ type Article struct {
 	Downvotes        int    // 비추 수
 	CreatedAt        time.Time
 }
+
+// session 메소드는 게시글이 속한 갤러리와 연결된 세션을 반환합니다
+func (article Article) session() (*Session, error) {
+	if article.Gallery == nil || article.Gallery.session == nil {
+		return nil, errors.WithMessage(ErrUnexpected, "세션과 연결된 갤러리 정보가 없습니다")
+	}
+
+	return article.Gallery.session, nil
+}
+
+// Delete 메소드는 게시글을 삭제합니다, 익명 게시글이라면 작성자 또는 비밀번호 저장소의 비밀번호를 사용합니다
+//
+// 삭제 후 저장소에서 게시글과 그 게시글에 달린 댓글의 비밀번호를 지우며 이에 실패하면 ErrVaultNotCleared 를 반환합니다
+func (article Article) Delete() error {
+	session, err := article.session()
+	if err != nil {
+		return err
+	}
+
+	key := VaultKey{Gallery: article.Gallery.ID, Article: article.ID}
+	payload := H{
+		"ci_t":       session.token(),
+		"id":         article.Gallery.ID,
+		"no":         strconv.FormatInt(article.ID, 10),
+		"_GALLTYPE_": article.Gallery.Type.code(),
+	}
+
+	if password := session.password(key, article.Author); password != "" {
+		payload["password"] = password
+	}
+
+	res, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(payload).
+		Post("https://gall.dcinside.com/board/forms/delete_submit")
+	if err != nil {
+		return errors.WithMessage(err, "게시글 삭제 요청 중 오류가 발생했습니다")
+	}
+
+	if _, err := submitResult(res.String()); err != nil {
+		return errors.WithMessage(err, "게시글 삭제 후 서버가 오류를 반환했습니다")
+	}
+
+	return session.forget(key)
+}
+
+// Edit 메소드는 게시글의 말머리, 제목과 내용을 수정하고 수정된 게시글을 반환합니다
+//
+// 익명 게시글이라면 작성자 또는 비밀번호 저장소의 비밀번호를 사용하며 첨부 파일은 수정할 수 없습니다
+func (article Article) Edit(draft Draft) (*Article, error) {
+	session, err := article.session()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(draft.Attachments) > 0 {
+		return nil, errors.WithMessage(ErrUnexpected, "게시글 수정 시 첨부 파일은 지원하지 않습니다")
+	}
+
+	key := VaultKey{Gallery: article.Gallery.ID, Article: article.ID}
+
+	draft.Gallery = article.Gallery
+	if draft.Author == nil {
+		draft.Author = article.Author
+	}
+
+	if draft.anonymous() {
+		author := User{}
+		if draft.Author != nil {
+			author = *draft.Author
+		}
+
+		author.Password = session.password(key, &author)
+		draft.Author = &author
+	}
+
+	if err := draft.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := H{}
+
+	// 수정 양식에 숨겨진 값 가져오기, 익명 게시글은 비밀번호를 함께 보내야 양식을 받을 수 있음
+	{
+		req := session.Client.R().
+			SetQueryParams(H{
+				"id": article.Gallery.ID,
+				"no": strconv.FormatInt(article.ID, 10),
+			})
+
+		if draft.anonymous() {
+			req.SetFormData(H{"password": draft.Author.Password})
+		}
+
+		res, err := req.Post(galleryEndpoints[article.Gallery.Type] + "/modify/")
+		if err != nil {
+			return nil, errors.WithMessage(err, "게시글 수정 페이지 요청 중 오류가 발생했습니다")
+		}
+
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+		if err != nil {
+			return nil, errors.WithMessage(err, "게시글 수정 페이지 파싱 중 오류가 발생했습니다")
+		}
+
+		doc.Find("#write input[type=hidden]").Each(func(_ int, s *goquery.Selection) {
+			if name := s.AttrOr("name", ""); name != "" {
+				payload[name] = s.AttrOr("value", "")
+			}
+		})
+	}
+
+	payload["ci_t"] = session.token()
+	payload["id"] = article.Gallery.ID
+	payload["no"] = strconv.FormatInt(article.ID, 10)
+	payload["mode"] = "U"
+	payload["subject"] = draft.Subject
+	payload["memo"] = draft.Content
+	payload["_GALLTYPE_"] = article.Gallery.Type.code()
+
+	if draft.Head != 0 {
+		payload["headtext"] = strconv.Itoa(draft.Head)
+	}
+
+	if draft.anonymous() {
+		payload["name"] = draft.Author.Nickname
+		payload["password"] = draft.Author.Password
+	}
+
+	res, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(payload).
+		Post("https://gall.dcinside.com/board/forms/article_submit")
+	if err != nil {
+		return nil, errors.WithMessage(err, "게시글 수정 요청 중 오류가 발생했습니다")
+	}
+
+	if _, err := submitResult(res.String()); err != nil {
+		return nil, errors.WithMessage(err, "게시글 수정 후 서버가 오류를 반환했습니다")
+	}
+
+	article.Author = draft.Author
+	article.Subject = draft.Subject
+	article.Content = draft.Content
+
+	return &article, nil
+}
@@ -0,0 +1,123 @@
+package dc
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Comment struct {
+	ID        int64
+	Article   *Article
+	Author    *User
+	Content   string
+	CreatedAt time.Time
+}
+
+// WriteComment 메소드는 게시글에 댓글을 작성합니다, 작성자가 없거나 Member 플래그가 없다면 익명으로 작성합니다
+//
+// 작성 후 익명 비밀번호 기록에 실패하면 작성된 댓글과 오류를 함께 반환합니다
+func (article *Article) WriteComment(content string, author *User) (*Comment, error) {
+	session, err := article.session()
+	if err != nil {
+		return nil, err
+	}
+
+	payload := H{
+		"ci_t":       session.token(),
+		"id":         article.Gallery.ID,
+		"no":         strconv.FormatInt(article.ID, 10),
+		"memo":       content,
+		"_GALLTYPE_": article.Gallery.Type.code(),
+	}
+
+	if author == nil {
+		author = session.User
+	}
+
+	anonymous := author == nil || !author.Flags.Has(Member)
+	if anonymous {
+		if author == nil {
+			return nil, errors.WithMessage(ErrInvalidNickname, "익명 댓글은 닉네임과 비밀번호가 필요합니다")
+		}
+
+		payload["name"] = author.Nickname
+		payload["password"] = author.Password
+	}
+
+	res, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(payload).
+		Post("https://gall.dcinside.com/board/forms/comment_submit")
+	if err != nil {
+		return nil, errors.WithMessage(err, "댓글 작성 요청 중 오류가 발생했습니다")
+	}
+
+	result, err := submitResult(res.String())
+	if err != nil {
+		return nil, errors.WithMessage(err, "댓글 작성 후 서버가 오류를 반환했습니다")
+	}
+
+	id, _ := strconv.ParseInt(result, 10, 64)
+	comment := &Comment{
+		ID:        id,
+		Article:   article,
+		Author:    author,
+		Content:   content,
+		CreatedAt: time.Now(),
+	}
+
+	// 익명으로 작성했다면 나중에 삭제할 수 있도록 비밀번호 기록하기
+	// 댓글은 이미 작성됐으므로 기록에 실패해도 작성된 댓글을 오류와 함께 반환함
+	if anonymous {
+		key := VaultKey{Gallery: article.Gallery.ID, Article: article.ID, Comment: id}
+		if err := session.remember(key, author.Password); err != nil {
+			return comment, err
+		}
+	}
+
+	return comment, nil
+}
+
+// Delete 메소드는 댓글을 삭제합니다, 익명 댓글이라면 작성자 또는 비밀번호 저장소의 비밀번호를 사용합니다
+//
+// 삭제 후 저장소에서 비밀번호를 지우지 못하면 ErrVaultNotCleared 를 반환합니다
+func (comment Comment) Delete() error {
+	if comment.Article == nil {
+		return errors.WithMessage(ErrUnexpected, "댓글이 속한 게시글 정보가 없습니다")
+	}
+
+	session, err := comment.Article.session()
+	if err != nil {
+		return err
+	}
+
+	gallery := comment.Article.Gallery
+	key := VaultKey{Gallery: gallery.ID, Article: comment.Article.ID, Comment: comment.ID}
+	payload := H{
+		"ci_t":       session.token(),
+		"id":         gallery.ID,
+		"no":         strconv.FormatInt(comment.Article.ID, 10),
+		"re_no":      strconv.FormatInt(comment.ID, 10),
+		"_GALLTYPE_": gallery.Type.code(),
+	}
+
+	if password := session.password(key, comment.Author); password != "" {
+		payload["re_password"] = password
+	}
+
+	res, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(payload).
+		Post("https://gall.dcinside.com/board/comment/comment_delete_submit")
+	if err != nil {
+		return errors.WithMessage(err, "댓글 삭제 요청 중 오류가 발생했습니다")
+	}
+
+	if _, err := submitResult(res.String()); err != nil {
+		return errors.WithMessage(err, "댓글 삭제 후 서버가 오류를 반환했습니다")
+	}
+
+	return session.forget(key)
+}
@@ -1,11 +1,20 @@
 package dc
 
-import "github.com/pkg/errors"
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 type H = map[string]string
 
+// 디시인사이드에 표시되는 모든 시각은 한국 표준시 기준
+var kst = time.FixedZone("KST", 9*60*60)
+
 var (
 	ErrTemporaryIPBanned = errors.New("아이피가 일시적으로 차단됐습니다")
 	ErrUnexpected        = errors.New("예측하지 못한 결과가 발생했습니다")
 	ErrNotFound          = errors.New("찾을 수 없거나 존재하지 않습니다")
+	ErrForbidden         = errors.New("권한이 없습니다")
+	ErrNotSupported      = errors.New("지원하지 않는 갤러리 종류입니다")
 )
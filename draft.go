@@ -0,0 +1,287 @@
+package dc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+// 디시인사이드 글쓰기 양식의 제한 값
+const (
+	MaxSubjectLength  = 40    // 제목 최대 글자 수
+	MaxContentLength  = 50000 // 내용 최대 글자 수
+	MinNicknameLength = 2     // 익명 닉네임 최소 글자 수
+	MaxNicknameLength = 20    // 익명 닉네임 최대 글자 수
+	MinPasswordLength = 2     // 익명 비밀번호 최소 글자 수
+	MaxPasswordLength = 20    // 익명 비밀번호 최대 글자 수
+	MaxAttachments    = 50    // 첨부 파일 최대 개수
+)
+
+// AttachmentScheme 는 게시글 내용에서 첨부 파일을 참조할 때 쓰는 주소 접두사입니다 (예: <img src="attachment:cat.png">)
+const AttachmentScheme = "attachment:"
+
+var (
+	ErrEmptySubject       = errors.New("제목을 입력해야 합니다")
+	ErrSubjectTooLong     = errors.New("제목이 너무 깁니다")
+	ErrEmptyContent       = errors.New("내용을 입력해야 합니다")
+	ErrContentTooLong     = errors.New("내용이 너무 깁니다")
+	ErrHeadRequired       = errors.New("말머리를 선택해야 합니다")
+	ErrInvalidHead        = errors.New("갤러리에 존재하지 않는 말머리입니다")
+	ErrInvalidNickname    = errors.New("닉네임 길이가 올바르지 않습니다")
+	ErrInvalidPassword    = errors.New("비밀번호 길이가 올바르지 않습니다")
+	ErrTooManyAttachments = errors.New("첨부 파일이 너무 많습니다")
+)
+
+// Draft 는 갤러리에 작성할 게시글입니다
+type Draft struct {
+	Gallery     *Gallery
+	Author      *User // 비어있으면 세션 사용자, Member 플래그가 없으면 익명으로 작성
+	Head        int   // 말머리 번호 (0 은 말머리 없음)
+	Subject     string
+	Content     string // 에디터 HTML
+	Attachments []Attachment
+}
+
+// Attachment 는 게시글에 첨부할 파일입니다
+type Attachment struct {
+	Name string
+	Data []byte
+}
+
+// DraftError 는 게시글 검증 중 발견된 모든 문제를 담고 있는 오류입니다
+type DraftError struct {
+	Problems []error
+}
+
+func (e *DraftError) Error() string {
+	messages := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		messages[i] = p.Error()
+	}
+
+	return strings.Join(messages, ", ")
+}
+
+// Is 메소드는 검증 오류에 target 오류가 포함되어 있는지 확인합니다
+func (e *DraftError) Is(target error) bool {
+	for _, p := range e.Problems {
+		if errors.Is(p, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// anonymous 메소드는 게시글이 익명으로 작성되는지 확인합니다
+func (draft Draft) anonymous() bool {
+	if draft.Author == nil {
+		return draft.Gallery == nil || draft.Gallery.session == nil || draft.Gallery.session.User == nil
+	}
+
+	return !draft.Author.Flags.Has(Member)
+}
+
+// Validate 메소드는 게시글이 디시인사이드 글쓰기 제약을 만족하는지 검사하고 발견된 모든 문제를 반환합니다
+func (draft Draft) Validate() error {
+	problems := []error{}
+
+	switch subject := utf8.RuneCountInString(strings.TrimSpace(draft.Subject)); {
+	case subject < 1:
+		problems = append(problems, ErrEmptySubject)
+	case subject > MaxSubjectLength:
+		problems = append(problems, errors.WithMessagef(ErrSubjectTooLong, "%d자 초과", MaxSubjectLength))
+	}
+
+	switch content := utf8.RuneCountInString(strings.TrimSpace(draft.Content)); {
+	case content < 1:
+		problems = append(problems, ErrEmptyContent)
+	case content > MaxContentLength:
+		problems = append(problems, errors.WithMessagef(ErrContentTooLong, "%d자 초과", MaxContentLength))
+	}
+
+	// 말머리는 갤러리 정보가 있을 때만 검사하기
+	if gallery := draft.Gallery; gallery != nil {
+		if draft.Head == 0 {
+			if gallery.HeadRequired {
+				problems = append(problems, ErrHeadRequired)
+			}
+		} else if !gallery.hasHead(draft.Head) {
+			problems = append(problems, errors.WithMessagef(ErrInvalidHead, "%d", draft.Head))
+		}
+	}
+
+	if draft.anonymous() {
+		var nickname, password string
+		if draft.Author != nil {
+			nickname = draft.Author.Nickname
+			password = draft.Author.Password
+		}
+
+		if n := utf8.RuneCountInString(nickname); n < MinNicknameLength || n > MaxNicknameLength {
+			problems = append(problems, errors.WithMessagef(ErrInvalidNickname, "%d~%d자", MinNicknameLength, MaxNicknameLength))
+		}
+
+		if n := utf8.RuneCountInString(password); n < MinPasswordLength || n > MaxPasswordLength {
+			problems = append(problems, errors.WithMessagef(ErrInvalidPassword, "%d~%d자", MinPasswordLength, MaxPasswordLength))
+		}
+	}
+
+	if len(draft.Attachments) > MaxAttachments {
+		problems = append(problems, errors.WithMessagef(ErrTooManyAttachments, "최대 %d개", MaxAttachments))
+	}
+
+	if len(problems) > 0 {
+		return &DraftError{Problems: problems}
+	}
+
+	return nil
+}
+
+// submitResult 함수는 'true||값' 또는 'false||오류 메세지' 형식의 글쓰기 양식 결과를 파싱합니다
+func submitResult(body string) (string, error) {
+	parts := strings.SplitN(body, "||", 2)
+	if len(parts) < 2 {
+		return "", errors.WithMessagef(ErrUnexpected, "서버가 예측하지 못한 결과를 반환했습니다: %s", body)
+	}
+
+	if parts[0] != "true" {
+		return "", errors.WithMessage(ErrUnexpected, parts[1])
+	}
+
+	return parts[1], nil
+}
+
+// Write 메소드는 검증을 마친 게시글을 갤러리에 작성하고 작성된 게시글을 반환합니다
+//
+// 작성 후 익명 비밀번호 기록에 실패하면 작성된 게시글과 오류를 함께 반환합니다
+func (gallery *Gallery) Write(draft Draft) (*Article, error) {
+	draft.Gallery = gallery
+
+	if err := draft.Validate(); err != nil {
+		return nil, err
+	}
+
+	session := gallery.session
+	payload := H{}
+
+	// 글쓰기 양식에 숨겨진 값 가져오기
+	{
+		res, err := session.Client.R().
+			SetQueryParam("id", gallery.ID).
+			Get(galleryEndpoints[gallery.Type] + "/write/")
+		if err != nil {
+			return nil, errors.WithMessage(err, "게시글 작성 페이지 요청 중 오류가 발생했습니다")
+		}
+
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+		if err != nil {
+			return nil, errors.WithMessage(err, "게시글 작성 페이지 파싱 중 오류가 발생했습니다")
+		}
+
+		doc.Find("#write input[type=hidden]").Each(func(_ int, s *goquery.Selection) {
+			if name := s.AttrOr("name", ""); name != "" {
+				payload[name] = s.AttrOr("value", "")
+			}
+		})
+	}
+
+	content := draft.Content
+
+	// 첨부 파일 업로드하기
+	for i, attachment := range draft.Attachments {
+		res, err := session.Client.R().
+			SetQueryParams(H{
+				"id":    gallery.ID,
+				"r_key": payload["r_key"],
+			}).
+			SetFileReader("files[]", attachment.Name, bytes.NewReader(attachment.Data)).
+			Post("https://upimg.dcinside.com/upimg_file.php")
+		if err != nil {
+			return nil, errors.WithMessagef(err, "첨부 파일 업로드 요청 중 오류가 발생했습니다: %s", attachment.Name)
+		}
+
+		var result struct {
+			Files []struct {
+				No  string `json:"_no"`
+				URL string `json:"url"`
+			} `json:"files"`
+		}
+
+		if err := json.Unmarshal(res.Body(), &result); err != nil || len(result.Files) < 1 {
+			return nil, errors.WithMessagef(ErrUnexpected, "첨부 파일 업로드 결과를 파싱할 수 없습니다: %s", attachment.Name)
+		}
+
+		file := result.Files[0]
+		payload[fmt.Sprintf("file_write[%d][file_no]", i)] = file.No
+
+		// 내용에서 첨부 파일을 참조하고 있다면 업로드된 주소로 바꾸고 아니라면 내용 끝에 붙이기
+		ref := AttachmentScheme + attachment.Name
+		if strings.Contains(content, ref) {
+			content = strings.ReplaceAll(content, ref, file.URL)
+		} else {
+			content += fmt.Sprintf(`<p><img src="%s"></p>`, file.URL)
+		}
+	}
+
+	payload["ci_t"] = session.token()
+	payload["id"] = gallery.ID
+	payload["mode"] = "W"
+	payload["subject"] = draft.Subject
+	payload["memo"] = content
+	payload["_GALLTYPE_"] = gallery.Type.code()
+
+	if draft.Head != 0 {
+		payload["headtext"] = strconv.Itoa(draft.Head)
+	}
+
+	author := draft.Author
+	if author == nil {
+		author = session.User
+	}
+
+	if draft.anonymous() {
+		payload["name"] = author.Nickname
+		payload["password"] = author.Password
+	}
+
+	res, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(payload).
+		Post("https://gall.dcinside.com/board/forms/article_submit")
+	if err != nil {
+		return nil, errors.WithMessage(err, "게시글 작성 요청 중 오류가 발생했습니다")
+	}
+
+	result, err := submitResult(res.String())
+	if err != nil {
+		return nil, errors.WithMessage(err, "게시글 작성 후 서버가 오류를 반환했습니다")
+	}
+
+	id, _ := strconv.ParseInt(result, 10, 64)
+	article := &Article{
+		ID:        id,
+		Gallery:   gallery,
+		Author:    author,
+		Subject:   draft.Subject,
+		Content:   content,
+		CreatedAt: time.Now(),
+	}
+
+	// 익명으로 작성했다면 나중에 수정하거나 삭제할 수 있도록 비밀번호 기록하기
+	// 게시글은 이미 작성됐으므로 기록에 실패해도 작성된 게시글을 오류와 함께 반환함
+	if draft.anonymous() {
+		if err := session.remember(VaultKey{Gallery: gallery.ID, Article: id}, author.Password); err != nil {
+			return article, err
+		}
+	}
+
+	return article, nil
+}
@@ -0,0 +1,54 @@
+package dc_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/toriato/dc"
+)
+
+func TestDraftValidate(t *testing.T) {
+	gallery := &dc.Gallery{
+		ID:           "test",
+		Heads:        []dc.ArticleHead{{ID: 10, Name: "일반"}},
+		HeadRequired: true,
+	}
+
+	// 정상적인 익명 게시글은 오류를 반환해선 안됨
+	draft := dc.Draft{
+		Gallery: gallery,
+		Author:  &dc.User{Nickname: "ㅇㅇ", Password: "1234"},
+		Head:    10,
+		Subject: "제목",
+		Content: "<p>내용</p>",
+	}
+	assert.NoError(t, draft.Validate())
+
+	// 모든 문제가 한번에 반환되어야함
+	draft = dc.Draft{
+		Gallery:     gallery,
+		Author:      &dc.User{Nickname: "ㅇ"},
+		Subject:     strings.Repeat("가", dc.MaxSubjectLength+1),
+		Attachments: make([]dc.Attachment, dc.MaxAttachments+1),
+	}
+
+	err := draft.Validate()
+	assert.ErrorIs(t, err, dc.ErrSubjectTooLong)
+	assert.ErrorIs(t, err, dc.ErrEmptyContent)
+	assert.ErrorIs(t, err, dc.ErrHeadRequired)
+	assert.ErrorIs(t, err, dc.ErrInvalidNickname)
+	assert.ErrorIs(t, err, dc.ErrInvalidPassword)
+	assert.ErrorIs(t, err, dc.ErrTooManyAttachments)
+	assert.NotErrorIs(t, err, dc.ErrEmptySubject)
+
+	// 갤러리에 없는 말머리는 허용되지 않음
+	draft = dc.Draft{
+		Gallery: gallery,
+		Author:  &dc.User{Nickname: "ㅇㅇ", Password: "1234"},
+		Head:    99,
+		Subject: "제목",
+		Content: "내용",
+	}
+	assert.ErrorIs(t, draft.Validate(), dc.ErrInvalidHead)
+}
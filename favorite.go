@@ -0,0 +1,95 @@
+package dc
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// Favorites 메소드는 로그인한 사용자의 즐겨찾기 갤러리 목록을 순서대로 불러옵니다
+func (session *Session) Favorites() ([]Gallery, error) {
+	if session.User == nil {
+		return nil, ErrUnauthorized
+	}
+
+	res, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(H{"ci_t": session.token()}).
+		Post("https://gall.dcinside.com/ajax/favorite_ajax/lists")
+	if err != nil {
+		return nil, errors.WithMessage(err, "즐겨찾기 목록 요청 중 오류가 발생했습니다")
+	}
+
+	var result struct {
+		List []struct {
+			ID   string `json:"id"`
+			Name string `json:"ko_name"`
+			Type string `json:"gall_type"`
+		} `json:"list"`
+	}
+
+	if err := json.Unmarshal(res.Body(), &result); err != nil {
+		return nil, errors.WithMessage(err, "즐겨찾기 목록 파싱 중 오류가 발생했습니다")
+	}
+
+	galleries := make([]Gallery, 0, len(result.List))
+	for _, item := range result.List {
+		galleries = append(galleries, Gallery{
+			session: session,
+			ID:      item.ID,
+			Name:    item.Name,
+			Type:    galleryTypeFromCode(item.Type),
+		})
+	}
+
+	return galleries, nil
+}
+
+// AddFavorite 메소드는 갤러리를 즐겨찾기 목록에 추가합니다
+func (session *Session) AddFavorite(gallery Gallery) error {
+	payload := url.Values{}
+	payload.Set("id", gallery.ID)
+	payload.Set("_GALLTYPE_", gallery.Type.code())
+
+	return session.favorite("add", payload)
+}
+
+// RemoveFavorite 메소드는 갤러리를 즐겨찾기 목록에서 제거합니다
+func (session *Session) RemoveFavorite(gallery Gallery) error {
+	payload := url.Values{}
+	payload.Set("id", gallery.ID)
+	payload.Set("_GALLTYPE_", gallery.Type.code())
+
+	return session.favorite("delete", payload)
+}
+
+// ReorderFavorites 메소드는 즐겨찾기 목록을 주어진 갤러리 순서대로 정렬합니다
+func (session *Session) ReorderFavorites(galleries []Gallery) error {
+	payload := url.Values{}
+	for _, gallery := range galleries {
+		payload.Add("ids[]", gallery.ID)
+		payload.Add("gall_types[]", gallery.Type.code())
+	}
+
+	return session.favorite("sort", payload)
+}
+
+// favorite 메소드는 즐겨찾기 변경 요청을 보냅니다
+func (session *Session) favorite(action string, payload url.Values) error {
+	if session.User == nil {
+		return ErrUnauthorized
+	}
+
+	payload.Set("ci_t", session.token())
+
+	_, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormDataFromValues(payload).
+		Post("https://gall.dcinside.com/ajax/favorite_ajax/" + action)
+	if err != nil {
+		return errors.WithMessagef(err, "즐겨찾기 변경 요청 중 오류가 발생했습니다: %s", action)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package dc
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// 베스트 게시글 제목 앞에 붙는 원본 갤러리 이름 (예: [싱글벙글] 제목)
+	patternFeedGalleryName = regexp.MustCompile(`^\[([^\]]+)\]\s*`)
+)
+
+// RealtimeBest 메소드는 실시간 베스트 게시글 목록을 불러옵니다
+func (session *Session) RealtimeBest(page int) ([]Article, error) {
+	return session.feed("dcbest", "실시간 베스트", H{}, page)
+}
+
+// WeeklyBest 메소드는 주간 베스트 게시글 목록을 불러옵니다
+func (session *Session) WeeklyBest(page int) ([]Article, error) {
+	return session.feed("dcbest", "주간 베스트", H{"_dcbest": "week"}, page)
+}
+
+// MonthlyBest 메소드는 월간 베스트 게시글 목록을 불러옵니다
+func (session *Session) MonthlyBest(page int) ([]Article, error) {
+	return session.feed("dcbest", "월간 베스트", H{"_dcbest": "month"}, page)
+}
+
+// HitGallery 메소드는 힛갤에 선정된 게시글 목록을 불러옵니다
+func (session *Session) HitGallery(page int) ([]Article, error) {
+	articles, err := session.feed("hit", "HIT 갤러리", H{}, page)
+	if err != nil {
+		return nil, err
+	}
+
+	// 힛갤 게시글 작성자는 모두 힛갤 선정자
+	for _, article := range articles {
+		article.Author.Flags.Set(Hit)
+	}
+
+	return articles, nil
+}
+
+// feed 메소드는 사이트 전체 게시글 모음 갤러리의 목록을 불러와 각 게시글의 원본 갤러리를 Source 에 설정합니다
+//
+// 목록 링크가 모음 갤러리를 가리킨다면 원본 갤러리 아이디를 알 수 없으므로 제목 앞에 붙은 이름만 설정합니다
+func (session *Session) feed(id, name string, params H, page int) ([]Article, error) {
+	gallery := &Gallery{
+		session: session,
+		ID:      id,
+		Name:    name,
+		Type:    Major,
+	}
+
+	params["page"] = strconv.Itoa(page)
+
+	articles, err := gallery.list(params)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range articles {
+		article := &articles[i]
+
+		// 목록 링크가 다른 갤러리를 가리키고 있다면 그 갤러리가 원본 갤러리
+		if article.Gallery != gallery {
+			article.Source = article.Gallery
+		} else {
+			article.Source = &Gallery{session: session}
+		}
+
+		if matches := patternFeedGalleryName.FindStringSubmatch(article.Subject); len(matches) > 1 {
+			article.Source.Name = matches[1]
+			article.Subject = strings.TrimPrefix(article.Subject, matches[0])
+		}
+	}
+
+	return articles, nil
+}
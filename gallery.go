@@ -3,8 +3,10 @@ package dc
 import (
 	"bytes"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/pkg/errors"
@@ -13,9 +15,25 @@ import (
 type Gallery struct {
 	session *Session
 
-	ID   string
-	Name string
-	Type GalleryType
+	ID           string
+	Name         string
+	Type         GalleryType
+	Heads        []ArticleHead // 말머리 목록
+	HeadRequired bool          // 글 작성 시 말머리 선택 필수 여부
+	Description  string        // 갤러리 소개
+	Icon         string        // 갤러리 아이콘 주소
+	Manager      *User         // 매니저 (마이너, 미니 갤러리)
+	Moderators   []User        // 부매니저 목록 (마이너, 미니 갤러리)
+	Members      int           // 회원 수 (미니 갤러리)
+	Adult        bool          // 성인 갤러리 여부
+	Closed       bool          // 폐쇄된 갤러리 여부
+	CreatedAt    time.Time     // 개설일
+}
+
+// ArticleHead 는 갤러리에서 사용하는 말머리입니다
+type ArticleHead struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 type GalleryType int
@@ -24,16 +42,82 @@ const (
 	Major GalleryType = iota
 	Minor
 	Mini
+	Person // 인물 갤러리
 )
 
 var (
 	galleryEndpoints = map[GalleryType]string{
-		Major: "https://gall.dcinside.com/board",
-		Minor: "https://gall.dcinside.com/mgallery/board",
-		Mini:  "https://gall.dcinside.com/mini/board",
+		Major:  "https://gall.dcinside.com/board",
+		Minor:  "https://gall.dcinside.com/mgallery/board",
+		Mini:   "https://gall.dcinside.com/mini/board",
+		Person: "https://gall.dcinside.com/person/board",
 	}
+
+	patternListSearchHead = regexp.MustCompile(`listSearchHead\((\d+)\)`)
+	patternDate           = regexp.MustCompile(`(\d{4})[.-](\d{2})[.-](\d{2})`)
+	patternNumber         = regexp.MustCompile(`[\d,]+`)
+
+	// 게시글 목록 대신 보여지는 안내 페이지의 요소
+	selectorAdultNotice  = ".adult_certify_box, form[action*=adult_certify]"
+	selectorClosedNotice = ".gall_close_box"
 )
 
+// code 메소드는 갤러리 AJAX 요청에 사용되는 갤러리 종류 값을 반환합니다
+func (t GalleryType) code() string {
+	switch t {
+	case Minor:
+		return "M"
+	case Mini:
+		return "MI"
+	case Person:
+		return "PR"
+	}
+
+	return "G"
+}
+
+// galleryTypeFromCode 함수는 갤러리 AJAX 요청에 사용되는 갤러리 종류 값으로부터 갤러리 종류를 확인합니다
+func galleryTypeFromCode(code string) GalleryType {
+	for _, t := range []GalleryType{Major, Minor, Mini, Person} {
+		if t.code() == code {
+			return t
+		}
+	}
+
+	return Major
+}
+
+// hasHead 메소드는 갤러리에 주어진 번호의 말머리가 있는지 확인합니다, 말머리 목록을 모른다면 항상 참입니다
+func (gallery *Gallery) hasHead(id int) bool {
+	if len(gallery.Heads) < 1 {
+		return true
+	}
+
+	for _, head := range gallery.Heads {
+		if head.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// galleryTypeFromPath 함수는 갤러리 주소의 경로로부터 갤러리 종류를 확인합니다
+func galleryTypeFromPath(p string) (GalleryType, bool) {
+	switch {
+	case strings.HasPrefix(p, "/board"):
+		return Major, true
+	case strings.HasPrefix(p, "/mgallery"):
+		return Minor, true
+	case strings.HasPrefix(p, "/mini"):
+		return Mini, true
+	case strings.HasPrefix(p, "/person"):
+		return Person, true
+	}
+
+	return Major, false
+}
+
 func (session *Session) NewGallery(id string, mini bool) (*Gallery, error) {
 	gallery := &Gallery{
 		session: session,
@@ -47,7 +131,7 @@ func (session *Session) NewGallery(id string, mini bool) (*Gallery, error) {
 
 	// 갤러리 종류 확인하기
 	{
-		res, err := session.Client.R().Get(endpoint)
+		res, err := session.gateway(endpoint)
 		if err != nil {
 			return nil, errors.WithMessage(err, "갤러리 게이트웨이 페이지를 요청하는 중 오류가 발생했습니다")
 		}
@@ -57,18 +141,13 @@ func (session *Session) NewGallery(id string, mini bool) (*Gallery, error) {
 			endpoint = res.Header().Get("Location")
 
 			u, _ := url.Parse(endpoint)
-			p := u.Path
-
-			switch {
-			case strings.HasPrefix(p, "/board"):
-				gallery.Type = Major
-			case strings.HasPrefix(p, "/mgallery"):
-				gallery.Type = Minor
-			case strings.HasPrefix(p, "/mini"):
-				gallery.Type = Mini
-			default:
+
+			t, ok := galleryTypeFromPath(u.Path)
+			if !ok {
 				return nil, ErrUnexpected
 			}
+
+			gallery.Type = t
 		case 404:
 			return nil, ErrNotFound
 		default:
@@ -77,27 +156,128 @@ func (session *Session) NewGallery(id string, mini bool) (*Gallery, error) {
 	}
 
 	// 갤러리 메인 페이지에서 정보 불러오기
-	{
-		res, err := session.Client.R().Get(endpoint)
-		if err != nil {
-			return nil, errors.WithMessage(err, "갤러리 게시글 목록 페이지 요청 중 오류가 발생했습니다")
+	if err := gallery.load(endpoint); err != nil {
+		return nil, err
+	}
+
+	return gallery, nil
+}
+
+// load 메소드는 갤러리 게시글 목록 페이지에서 갤러리 정보를 불러옵니다
+func (gallery *Gallery) load(endpoint string) error {
+	res, err := gallery.session.Client.R().Get(endpoint)
+	if err != nil {
+		return errors.WithMessage(err, "갤러리 게시글 목록 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return errors.WithMessage(err, "갤러리 게시글 목록 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	gallery.Name = doc.Find("meta[name=title]").AttrOr("content", "")
+	gallery.parseInfo(doc)
+
+	// 말머리 목록 파싱하기
+	gallery.Heads = nil
+	doc.Find(".center_box li a[onclick^=listSearchHead]").Each(func(_ int, s *goquery.Selection) {
+		matches := patternListSearchHead.FindStringSubmatch(s.AttrOr("onclick", ""))
+		if len(matches) < 2 {
+			return
 		}
 
-		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
-		if err != nil {
-			return nil, errors.WithMessage(err, "갤러리 게시글 목록 페이지 파싱 중 오류가 발생했습니다")
+		// 0번 말머리는 '전체' 탭이므로 무시하기
+		id, _ := strconv.Atoi(matches[1])
+		if id == 0 {
+			return
 		}
 
-		gallery.Name = doc.Find("meta[name=title]").AttrOr("content", "")
+		gallery.Heads = append(gallery.Heads, ArticleHead{
+			ID:   id,
+			Name: strings.TrimSpace(s.Text()),
+		})
+	})
+
+	gallery.HeadRequired = doc.Find("#subject_essential").AttrOr("value", "") == "1"
+
+	return nil
+}
+
+// parseInfo 메소드는 갤러리 게시글 목록 페이지에서 갤러리 정보를 파싱합니다
+func (gallery *Gallery) parseInfo(doc *goquery.Document) {
+	gallery.Adult, gallery.Closed = galleryNotice(doc)
+
+	infoRef := doc.Find(".minor_intro_box")
+
+	gallery.Description = strings.TrimSpace(infoRef.Find(".intro_txt").Text())
+	if gallery.Description == "" {
+		gallery.Description = doc.Find("meta[name=description]").AttrOr("content", "")
 	}
 
-	return gallery, nil
+	gallery.Icon = infoRef.Find(".img_box img").AttrOr("src", "")
+
+	// 개설일
+	if matches := patternDate.FindStringSubmatch(infoRef.Find(".open_date").Text()); len(matches) > 0 {
+		date, _ := time.ParseInLocation("2006-01-02", strings.Join(matches[1:], "-"), kst)
+		gallery.CreatedAt = date
+	}
+
+	// 매니저와 부매니저
+	infoRef.Find(".manager a").Each(func(_ int, s *goquery.Selection) {
+		if user := userFromGallogLink(s); user != nil {
+			user.Flags.Set(Manager)
+			gallery.Manager = user
+		}
+	})
+
+	infoRef.Find(".sub_manager a").Each(func(_ int, s *goquery.Selection) {
+		if user := userFromGallogLink(s); user != nil {
+			user.Flags.Set(Moderator)
+			gallery.Moderators = append(gallery.Moderators, *user)
+		}
+	})
+
+	// 미니 갤러리 회원 수
+	if gallery.Type == Mini {
+		gallery.Members = parseNumber(infoRef.Find(".member_num").Text())
+	}
+}
+
+// galleryNotice 함수는 페이지가 성인 인증 또는 폐쇄 안내 페이지인지 안내 요소로 확인합니다
+func galleryNotice(doc *goquery.Document) (adult bool, closed bool) {
+	return doc.Find(selectorAdultNotice).Length() > 0, doc.Find(selectorClosedNotice).Length() > 0
 }
 
+// userFromGallogLink 함수는 갤로그로 연결되는 링크 요소에서 사용자 정보를 파싱합니다
+func userFromGallogLink(s *goquery.Selection) *User {
+	u, err := url.Parse(s.AttrOr("href", ""))
+	if err != nil || !strings.HasSuffix(u.Host, "gallog.dcinside.com") {
+		return nil
+	}
+
+	username := strings.SplitN(strings.Trim(u.Path, "/"), "/", 2)[0]
+	if username == "" {
+		return nil
+	}
+
+	return &User{
+		Username: username,
+		Nickname: strings.TrimSpace(s.Text()),
+		Flags:    Member,
+	}
+}
+
+// Articles 메소드는 갤러리의 게시글 목록을 불러옵니다
 func (gallery *Gallery) Articles(page int) ([]Article, error) {
+	return gallery.list(H{"page": strconv.Itoa(page)})
+}
+
+// list 메소드는 주어진 인자로 갤러리 게시글 목록 페이지를 요청해 게시글을 파싱합니다
+func (gallery *Gallery) list(params H) ([]Article, error) {
 	res, err := gallery.session.Client.R().
 		SetQueryParam("id", gallery.ID).
-		Get(galleryEndpoints[gallery.Type] + "/list")
+		SetQueryParams(params).
+		Get(galleryEndpoints[gallery.Type] + "/lists/")
 	if err != nil {
 		return nil, errors.WithMessage(err, "갤러리 게시글 목록 페이지 요청 중 오류가 발생했습니다")
 	}
@@ -110,55 +290,144 @@ func (gallery *Gallery) Articles(page int) ([]Article, error) {
 	articles := []Article{}
 
 	doc.Find(".gall_list .us-post").Each(func(_ int, s *goquery.Selection) {
-		article := Article{}
+		articles = append(articles, gallery.parseArticleRow(s))
+	})
 
-		titleAnchorRef := s.Find(".gall_tit a")
-		{
-			u, _ := url.Parse(titleAnchorRef.First().AttrOr("href", ""))
+	return articles, nil
+}
 
-			// 갤러리 구조 설정하기
-			galleryID := u.Query().Get("id")
+// parseArticleRow 메소드는 게시글 목록의 한 줄을 파싱합니다
+func (gallery *Gallery) parseArticleRow(s *goquery.Selection) Article {
+	article := Article{}
 
-			if galleryID == gallery.ID {
-				// 갤러리 아이디가 현재 갤러리 구조와 일치한다면 그대로 사용하기
-				article.Gallery = gallery
-			} else {
-				// 아이디만 있는 새 구조 만들어 사용하기
-				article.Gallery = &Gallery{ID: galleryID}
-			}
+	titleAnchorRef := s.Find(".gall_tit a").First()
+	{
+		u, _ := url.Parse(titleAnchorRef.AttrOr("href", ""))
+
+		// 갤러리 구조 설정하기
+		galleryID := u.Query().Get("id")
 
-			// 게시글 번호 파싱하기
-			id, _ := strconv.ParseInt(u.Query().Get("no"), 10, 64)
-			article.ID = id
+		if galleryID == gallery.ID {
+			// 갤러리 아이디가 현재 갤러리 구조와 일치한다면 그대로 사용하기
+			article.Gallery = gallery
+		} else {
+			// 링크에서 알 수 있는 아이디와 종류만 있는 새 구조 만들어 사용하기
+			t, _ := galleryTypeFromPath(u.Path)
+			article.Gallery = &Gallery{session: gallery.session, ID: galleryID, Type: t}
 		}
 
-		// 작성자 정보
-		writerRef := s.Find(".gall_writer")
-		article.Author = &User{
-			Username: writerRef.AttrOr("data-uid", "") + writerRef.AttrOr("data-ip", ""),
-			Nickname: writerRef.AttrOr("data-nick", ""),
+		// 게시글 번호 파싱하기
+		id, _ := strconv.ParseInt(u.Query().Get("no"), 10, 64)
+		article.ID = id
+	}
+
+	// 제목에 붙는 아이콘 요소를 제외하고 제목 가져오기
+	article.Subject = strings.TrimSpace(titleAnchorRef.Clone().Children().Remove().End().Text())
+
+	// 댓글 수는 '[댓글/보이스 리플]' 형식으로 표시됨
+	if parts := patternNumber.FindAllString(s.Find(".reply_num").Text(), 2); len(parts) > 0 {
+		article.TextComments = parseNumber(parts[0])
+		if len(parts) > 1 {
+			article.VoiceComments = parseNumber(parts[1])
 		}
+	}
 
-		// 작성자 아이콘
-		writerIconRef := writerRef.Find(".writer_nikcon img")
-		if writerIconRef.Length() > 0 {
-			src := writerIconRef.AttrOr("src", "")
-
-			switch {
-			case strings.Contains(src, "fix"):
-				article.Author.Flags.Set(Fixed)
-				fallthrough
-			case strings.Contains(src, "sub_manager"):
-				article.Author.Flags.Set(Moderator)
-			case strings.Contains(src, "manager"):
-				article.Author.Flags.Set(Manager)
-			}
+	article.Views = parseNumber(s.Find(".gall_count").Text())
+	article.Upvotes = parseNumber(s.Find(".gall_recommend").Text())
+
+	// 작성 시각
+	date, _ := time.ParseInLocation("2006-01-02 15:04:05", s.Find(".gall_date").AttrOr("title", ""), kst)
+	article.CreatedAt = date
+
+	// 작성자 정보
+	article.Author = parseWriter(s.Find(".gall_writer"))
 
-			article.Author.Flags.Set(Member)
+	return article
+}
+
+// parseWriter 함수는 작성자 요소의 속성과 아이콘에서 사용자 정보를 파싱합니다
+func parseWriter(writerRef *goquery.Selection) *User {
+	user := &User{
+		Username: writerRef.AttrOr("data-uid", "") + writerRef.AttrOr("data-ip", ""),
+		Nickname: writerRef.AttrOr("data-nick", ""),
+	}
+
+	// 작성자 아이콘
+	writerIconRef := writerRef.Find(".writer_nikcon img")
+	if writerIconRef.Length() > 0 {
+		src := writerIconRef.AttrOr("src", "")
+
+		if strings.Contains(src, "fix") {
+			user.Flags.Set(Fixed)
+		}
+
+		if strings.Contains(src, "hit") {
+			user.Flags.Set(Hit)
 		}
 
-		articles = append(articles, article)
+		switch {
+		case strings.Contains(src, "sub_manager"):
+			user.Flags.Set(Moderator)
+		case strings.Contains(src, "manager"):
+			user.Flags.Set(Manager)
+		}
+
+		user.Flags.Set(Member)
+	}
+
+	return user
+}
+
+// Search 메소드는 갤러리에서 제목과 내용에 검색어가 포함된 게시글 목록을 불러옵니다
+func (gallery *Gallery) Search(keyword string, page int) ([]Article, error) {
+	return gallery.list(H{
+		"page":      strconv.Itoa(page),
+		"s_type":    "search_subject_memo",
+		"s_keyword": keyword,
 	})
+}
 
-	return articles, nil
+// Article 메소드는 갤러리의 게시글을 내용과 함께 불러옵니다
+func (gallery *Gallery) Article(id int64) (*Article, error) {
+	res, err := gallery.session.Client.R().
+		SetQueryParams(H{
+			"id": gallery.ID,
+			"no": strconv.FormatInt(id, 10),
+		}).
+		Get(galleryEndpoints[gallery.Type] + "/view/")
+	if err != nil {
+		return nil, errors.WithMessage(err, "게시글 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "게시글 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	viewRef := doc.Find(".view_content_wrap")
+	if viewRef.Length() < 1 {
+		return nil, ErrNotFound
+	}
+
+	article := &Article{
+		ID:      id,
+		Gallery: gallery,
+		Author:  parseWriter(viewRef.Find(".gall_writer").First()),
+		Subject: strings.TrimSpace(viewRef.Find(".title_subject").Text()),
+	}
+
+	article.Content, _ = viewRef.Find(".write_div").Html()
+	article.Content = strings.TrimSpace(article.Content)
+
+	// 조회 수, 댓글 수, 추천 수
+	article.Views = parseNumber(doc.Find(".gall_count").First().Text())
+	article.TextComments = parseNumber(doc.Find(".gall_comment").First().Text())
+	article.Upvotes = parseNumber(doc.Find(".up_num").First().Text())
+	article.CertifiedUpvotes = parseNumber(doc.Find(".sup_num").First().Text())
+	article.Downvotes = parseNumber(doc.Find(".down_num").First().Text())
+
+	date, _ := time.ParseInLocation("2006.01.02 15:04:05", strings.TrimSpace(viewRef.Find(".gall_date").AttrOr("title", "")), kst)
+	article.CreatedAt = date
+
+	return article, nil
 }
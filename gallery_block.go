@@ -0,0 +1,116 @@
+package dc
+
+import (
+	"bytes"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+// Block 은 갤러리 관리자가 설정한 사용자 차단입니다
+type Block struct {
+	gallery *Gallery
+
+	ID        int64
+	User      *User // 유동이라면 Username 에 아이피 앞자리가 들어감
+	Reason    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+var (
+	managementEndpoints = map[GalleryType]string{
+		Minor: "https://gall.dcinside.com/mgallery/management",
+		Mini:  "https://gall.dcinside.com/mini/management",
+	}
+)
+
+// Block 메소드는 갤러리 관리 권한으로 사용자를 주어진 기간동안 차단합니다
+//
+// Member 플래그가 없는 사용자는 Username 을 아이피 앞자리 (예: 123.45) 로 간주합니다
+func (gallery *Gallery) Block(user User, duration time.Duration, reason string) error {
+	payload := url.Values{}
+	payload.Set("avoid_hour", strconv.Itoa(int(math.Ceil(duration.Hours()))))
+	payload.Set("avoid_reason_txt", reason)
+
+	if user.Flags.Has(Member) {
+		payload.Set("user_id", user.Username)
+	} else {
+		payload.Set("ip", user.Username)
+	}
+
+	return gallery.manage(Manager|Moderator, "update_avoid", payload)
+}
+
+// Blocks 메소드는 갤러리에 설정된 차단 목록을 불러옵니다
+func (gallery *Gallery) Blocks(page int) ([]Block, error) {
+	if _, err := gallery.authorize(Manager | Moderator); err != nil {
+		return nil, err
+	}
+
+	res, err := gallery.session.Client.R().
+		SetQueryParams(H{
+			"id": gallery.ID,
+			"p":  strconv.Itoa(page),
+		}).
+		Get(managementEndpoints[gallery.Type] + "/block")
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 차단 목록 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 차단 목록 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	blocks := []Block{}
+
+	doc.Find(".block_list tbody tr").Each(func(_ int, s *goquery.Selection) {
+		block := Block{
+			gallery: gallery,
+			User:    &User{},
+			Reason:  strings.TrimSpace(s.Find(".block_reason").Text()),
+		}
+
+		id, _ := strconv.ParseInt(s.AttrOr("data-no", ""), 10, 64)
+		block.ID = id
+
+		// 차단된 사용자 정보
+		writerRef := s.Find(".gall_writer")
+		block.User.Nickname = writerRef.AttrOr("data-nick", "")
+
+		if uid := writerRef.AttrOr("data-uid", ""); uid != "" {
+			block.User.Username = uid
+			block.User.Flags.Set(Member)
+		} else {
+			block.User.Username = writerRef.AttrOr("data-ip", "")
+		}
+
+		// 차단 기간
+		created, _ := time.ParseInLocation("2006.01.02 15:04", strings.TrimSpace(s.Find(".block_date").Text()), kst)
+		expires, _ := time.ParseInLocation("2006.01.02 15:04", strings.TrimSpace(s.Find(".block_end").Text()), kst)
+		block.CreatedAt = created
+		block.ExpiresAt = expires
+
+		blocks = append(blocks, block)
+	})
+
+	return blocks, nil
+}
+
+// Lift 메소드는 차단을 해제합니다
+func (block Block) Lift() error {
+	if block.gallery == nil {
+		return errors.WithMessage(ErrUnexpected, "차단이 속한 갤러리 정보가 없습니다")
+	}
+
+	payload := url.Values{}
+	payload.Set("avoid_no", strconv.FormatInt(block.ID, 10))
+
+	return block.gallery.manage(Manager|Moderator, "release_avoid", payload)
+}
@@ -0,0 +1,128 @@
+package dc
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+// GalleryCategory 는 갤러리 목록 페이지의 분류입니다
+type GalleryCategory struct {
+	Name      string
+	Galleries []Gallery
+}
+
+var (
+	galleryDirectoryEndpoints = map[GalleryType]string{
+		Major: "https://gall.dcinside.com/",
+		Minor: "https://gall.dcinside.com/m",
+		Mini:  "https://gall.dcinside.com/n",
+	}
+)
+
+// Directory 메소드는 주어진 종류의 모든 갤러리를 분류별로 불러옵니다
+func (session *Session) Directory(t GalleryType) ([]GalleryCategory, error) {
+	endpoint, ok := galleryDirectoryEndpoints[t]
+	if !ok {
+		return nil, errors.WithMessage(ErrNotSupported, "갤러리 목록을 제공하지 않습니다")
+	}
+
+	res, err := session.Client.R().Get(endpoint)
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 목록 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 목록 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	categories := []GalleryCategory{}
+
+	doc.Find(".gall_category").Each(func(_ int, s *goquery.Selection) {
+		category := GalleryCategory{
+			Name: strings.TrimSpace(s.Find(".category_tit").First().Text()),
+		}
+
+		// 여러 하위 분류에 같은 갤러리가 반복되는 경우가 있으므로 중복 제거하기
+		seen := map[string]bool{}
+
+		s.Find(`a[href*="id="]`).Each(func(_ int, a *goquery.Selection) {
+			u, err := url.Parse(a.AttrOr("href", ""))
+			if err != nil {
+				return
+			}
+
+			id := u.Query().Get("id")
+			if id == "" || seen[id] {
+				return
+			}
+			seen[id] = true
+
+			gallery := Gallery{
+				session: session,
+				ID:      id,
+				Name:    strings.TrimSpace(a.Text()),
+				Type:    t,
+			}
+
+			if found, ok := galleryTypeFromPath(u.Path); ok {
+				gallery.Type = found
+			}
+
+			category.Galleries = append(category.Galleries, gallery)
+		})
+
+		if len(category.Galleries) > 0 {
+			categories = append(categories, category)
+		}
+	})
+
+	return categories, nil
+}
+
+// SearchGalleries 메소드는 이름에 검색어가 포함된 모든 종류의 갤러리를 불러옵니다
+func (session *Session) SearchGalleries(keyword string) ([]Gallery, error) {
+	res, err := session.Client.R().
+		Get("https://search.dcinside.com/gallery/q/" + url.PathEscape(keyword))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 검색 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 검색 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	galleries := []Gallery{}
+	seen := map[string]bool{}
+
+	doc.Find(".integrate_cont_list a.gallname_txt").Each(func(_ int, a *goquery.Selection) {
+		u, err := url.Parse(a.AttrOr("href", ""))
+		if err != nil {
+			return
+		}
+
+		t, ok := galleryTypeFromPath(u.Path)
+		id := u.Query().Get("id")
+
+		// 같은 아이디라도 갤러리 종류가 다르면 다른 갤러리이므로 종류와 함께 중복 확인하기
+		key := t.code() + "/" + id
+		if !ok || id == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+
+		galleries = append(galleries, Gallery{
+			session: session,
+			ID:      id,
+			Name:    strings.TrimSpace(a.Text()),
+			Type:    t,
+		})
+	})
+
+	return galleries, nil
+}
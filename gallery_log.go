@@ -0,0 +1,145 @@
+package dc
+
+import (
+	"bytes"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+// ManageAction 은 갤러리 관리 내역에 기록되는 관리 동작의 종류입니다
+type ManageAction int
+
+const (
+	ManageUnknown     ManageAction = iota // 알 수 없는 동작
+	ManageDelete                          // 게시글 또는 댓글 삭제
+	ManageRecycle                         // 휴지통 이동
+	ManageBlock                           // 사용자 차단
+	ManageUnblock                         // 사용자 차단 해제
+	ManageRecommend                       // 개념글 지정
+	ManageUnrecommend                     // 개념글 해제
+	ManageNotice                          // 공지 등록
+	ManageUnnotice                        // 공지 해제
+	ManageHead                            // 말머리 변경
+)
+
+var (
+	// 관리 내역에 표시되는 동작 이름과 동작 종류, 해제 동작이 먼저 확인되도록 순서 유지하기
+	manageActionLabels = []struct {
+		label  string
+		action ManageAction
+	}{
+		{"차단 해제", ManageUnblock},
+		{"개념글 해제", ManageUnrecommend},
+		{"공지 해제", ManageUnnotice},
+		{"휴지통", ManageRecycle},
+		{"삭제", ManageDelete},
+		{"차단", ManageBlock},
+		{"개념글", ManageRecommend},
+		{"공지", ManageNotice},
+		{"말머리", ManageHead},
+	}
+)
+
+// ManageLogEntry 는 갤러리 관리 내역의 한 항목입니다
+type ManageLogEntry struct {
+	Action    ManageAction
+	Label     string   // 관리 내역에 표시된 동작 이름
+	Article   *Article // 대상 게시글 (없을 수 있음)
+	User      *User    // 대상 사용자 (없을 수 있음)
+	Manager   *User    // 동작을 실행한 관리자
+	Reason    string
+	CreatedAt time.Time
+}
+
+// ManageLog 메소드는 갤러리 관리 내역을 불러옵니다
+func (gallery *Gallery) ManageLog(page int) ([]ManageLogEntry, error) {
+	if _, err := gallery.authorize(Manager | Moderator); err != nil {
+		return nil, err
+	}
+
+	res, err := gallery.session.Client.R().
+		SetQueryParams(H{
+			"id": gallery.ID,
+			"p":  strconv.Itoa(page),
+		}).
+		Get(managementEndpoints[gallery.Type] + "/log")
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 관리 내역 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 관리 내역 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	entries := []ManageLogEntry{}
+
+	doc.Find(".log_list tbody tr").Each(func(_ int, s *goquery.Selection) {
+		entry := ManageLogEntry{
+			Label:  strings.TrimSpace(s.Find(".log_type").Text()),
+			Reason: strings.TrimSpace(s.Find(".log_reason").Text()),
+		}
+
+		for _, l := range manageActionLabels {
+			if strings.Contains(entry.Label, l.label) {
+				entry.Action = l.action
+				break
+			}
+		}
+
+		// 대상 게시글
+		if href, ok := s.Find(".log_subject a").Attr("href"); ok {
+			if u, err := url.Parse(href); err == nil {
+				id, _ := strconv.ParseInt(u.Query().Get("no"), 10, 64)
+				entry.Article = &Article{
+					ID:      id,
+					Gallery: gallery,
+					Subject: strings.TrimSpace(s.Find(".log_subject a").Text()),
+				}
+			}
+		}
+
+		// 대상 사용자
+		if writerRef := s.Find(".log_target .gall_writer"); writerRef.Length() > 0 {
+			entry.User = &User{
+				Username: writerRef.AttrOr("data-uid", "") + writerRef.AttrOr("data-ip", ""),
+				Nickname: writerRef.AttrOr("data-nick", ""),
+			}
+
+			if writerRef.AttrOr("data-uid", "") != "" {
+				entry.User.Flags.Set(Member)
+			}
+
+			if entry.Article != nil {
+				entry.Article.Author = entry.User
+			}
+		}
+
+		// 동작을 실행한 관리자
+		if managerRef := s.Find(".log_manager .gall_writer"); managerRef.Length() > 0 {
+			entry.Manager = &User{
+				Username: managerRef.AttrOr("data-uid", ""),
+				Nickname: managerRef.AttrOr("data-nick", ""),
+				Flags:    Member,
+			}
+
+			if gallery.Manager != nil && gallery.Manager.Username == entry.Manager.Username {
+				entry.Manager.Flags.Set(Manager)
+			} else {
+				entry.Manager.Flags.Set(Moderator)
+			}
+		}
+
+		date, _ := time.ParseInLocation("2006.01.02 15:04:05", strings.TrimSpace(s.Find(".log_date").Text()), kst)
+		entry.CreatedAt = date
+
+		entries = append(entries, entry)
+	})
+
+	return entries, nil
+}
@@ -0,0 +1,138 @@
+package dc
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// authorize 메소드는 세션 사용자가 갤러리에서 주어진 관리 권한 중 하나를 갖고 있는지 확인하고 사용자의 권한을 반환합니다
+func (gallery *Gallery) authorize(allowed UserFlag) (UserFlag, error) {
+	if gallery.Type != Minor && gallery.Type != Mini {
+		return 0, ErrNotSupported
+	}
+
+	user := gallery.session.User
+	if user == nil {
+		return 0, ErrUnauthorized
+	}
+
+	var flags UserFlag
+
+	if gallery.Manager != nil && gallery.Manager.Username == user.Username {
+		flags.Set(Manager)
+	}
+
+	for _, moderator := range gallery.Moderators {
+		if moderator.Username == user.Username {
+			flags.Set(Moderator)
+		}
+	}
+
+	if !flags.Has(allowed) {
+		return flags, errors.WithMessagef(ErrForbidden, "%s 사용자는 %s 갤러리의 관리자가 아닙니다", user, gallery.ID)
+	}
+
+	return flags, nil
+}
+
+// manage 메소드는 관리 권한을 확인한 뒤 갤러리 관리 AJAX 요청을 보냅니다
+func (gallery *Gallery) manage(allowed UserFlag, action string, payload url.Values) error {
+	if _, err := gallery.authorize(allowed); err != nil {
+		return err
+	}
+
+	endpoint := "https://gall.dcinside.com/ajax/minor_manager_board_ajax/"
+	if gallery.Type == Mini {
+		endpoint = "https://gall.dcinside.com/ajax/mini_manager_board_ajax/"
+	}
+
+	payload.Set("ci_t", gallery.session.token())
+	payload.Set("id", gallery.ID)
+	payload.Set("_GALLTYPE_", gallery.Type.code())
+
+	_, err := gallery.session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormDataFromValues(payload).
+		Post(endpoint + action)
+	if err != nil {
+		return errors.WithMessagef(err, "갤러리 관리 요청 중 오류가 발생했습니다: %s", action)
+	}
+
+	return nil
+}
+
+// DeleteArticles 메소드는 갤러리 관리 권한으로 여러 게시글을 한번에 삭제합니다
+func (gallery *Gallery) DeleteArticles(ids ...int64) error {
+	payload := url.Values{}
+	for _, id := range ids {
+		payload.Add("nos[]", strconv.FormatInt(id, 10))
+	}
+
+	return gallery.manage(Manager|Moderator, "delete_list", payload)
+}
+
+// RecycleArticles 메소드는 갤러리 관리 권한으로 여러 게시글을 휴지통으로 이동합니다
+func (gallery *Gallery) RecycleArticles(ids ...int64) error {
+	payload := url.Values{}
+	for _, id := range ids {
+		payload.Add("nos[]", strconv.FormatInt(id, 10))
+	}
+
+	return gallery.manage(Manager|Moderator, "move_recycle", payload)
+}
+
+// DeleteComments 메소드는 갤러리 관리 권한으로 게시글의 여러 댓글을 한번에 삭제합니다
+func (gallery *Gallery) DeleteComments(article int64, ids ...int64) error {
+	payload := url.Values{}
+	payload.Set("no", strconv.FormatInt(article, 10))
+	for _, id := range ids {
+		payload.Add("re_nos[]", strconv.FormatInt(id, 10))
+	}
+
+	return gallery.manage(Manager|Moderator, "delete_comment", payload)
+}
+
+// manage 메소드는 게시글이 속한 갤러리의 관리 권한으로 게시글 관리 요청을 보냅니다
+func (article Article) manage(action string, payload url.Values) error {
+	if article.Gallery == nil || article.Gallery.session == nil {
+		return errors.WithMessage(ErrUnexpected, "세션과 연결된 갤러리 정보가 없습니다")
+	}
+
+	payload.Add("nos[]", strconv.FormatInt(article.ID, 10))
+
+	return article.Gallery.manage(Manager|Moderator, action, payload)
+}
+
+// Recommend 메소드는 갤러리 관리 권한으로 게시글을 개념글로 지정합니다
+func (article Article) Recommend() error {
+	return article.manage("set_recommend", url.Values{})
+}
+
+// Unrecommend 메소드는 갤러리 관리 권한으로 게시글의 개념글 지정을 해제합니다
+func (article Article) Unrecommend() error {
+	return article.manage("unset_recommend", url.Values{})
+}
+
+// Notice 메소드는 갤러리 관리 권한으로 게시글을 공지로 등록합니다
+func (article Article) Notice() error {
+	return article.manage("set_notice", url.Values{})
+}
+
+// Unnotice 메소드는 갤러리 관리 권한으로 게시글의 공지 등록을 해제합니다
+func (article Article) Unnotice() error {
+	return article.manage("unset_notice", url.Values{})
+}
+
+// SetHead 메소드는 갤러리 관리 권한으로 게시글의 말머리를 변경합니다
+func (article Article) SetHead(head int) error {
+	if head != 0 && article.Gallery != nil && !article.Gallery.hasHead(head) {
+		return errors.WithMessagef(ErrInvalidHead, "%d", head)
+	}
+
+	payload := url.Values{}
+	payload.Set("headtext", strconv.Itoa(head))
+
+	return article.manage("update_headtext", payload)
+}
@@ -0,0 +1,88 @@
+package dc
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrApprovalRequired = errors.New("매니저의 가입 승인이 필요한 갤러리입니다")
+)
+
+// Join 메소드는 로그인한 사용자를 미니 갤러리에 가입시킵니다
+//
+// 매니저 승인이 필요한 갤러리라면 가입 신청 후 ErrApprovalRequired 오류를 반환합니다
+func (gallery *Gallery) Join() error {
+	return gallery.membership("join", "미니 갤러리 가입")
+}
+
+// Leave 메소드는 로그인한 사용자를 미니 갤러리에서 탈퇴시킵니다
+func (gallery *Gallery) Leave() error {
+	return gallery.membership("leave", "미니 갤러리 탈퇴")
+}
+
+func (gallery *Gallery) membership(action, description string) error {
+	if gallery.Type != Mini {
+		return ErrNotSupported
+	}
+
+	if gallery.session.User == nil {
+		return ErrUnauthorized
+	}
+
+	_, err := gallery.session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(H{
+			"ci_t": gallery.session.token(),
+			"id":   gallery.ID,
+		}).
+		Post("https://gall.dcinside.com/mini/ajax/member_ajax/" + action)
+	if err != nil {
+		if errors.Is(err, ErrApprovalRequired) {
+			return err
+		}
+
+		return errors.WithMessagef(err, "%s 요청 중 오류가 발생했습니다", description)
+	}
+
+	return nil
+}
+
+// MiniGalleries 메소드는 로그인한 사용자가 가입한 미니 갤러리 목록을 불러옵니다
+func (session *Session) MiniGalleries() ([]Gallery, error) {
+	if session.User == nil {
+		return nil, ErrUnauthorized
+	}
+
+	res, err := session.Client.R().Get("https://gall.dcinside.com/n")
+	if err != nil {
+		return nil, errors.WithMessage(err, "미니 갤러리 메인 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "미니 갤러리 메인 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	galleries := []Gallery{}
+
+	doc.Find(`.my_minigall a[href*="id="]`).Each(func(_ int, a *goquery.Selection) {
+		u, err := url.Parse(a.AttrOr("href", ""))
+		if err != nil {
+			return
+		}
+
+		galleries = append(galleries, Gallery{
+			session: session,
+			ID:      u.Query().Get("id"),
+			Name:    strings.TrimSpace(a.Text()),
+			Type:    Mini,
+		})
+	})
+
+	return galleries, nil
+}
@@ -0,0 +1,86 @@
+package dc
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GalleryRank 는 마이너 또는 미니 갤러리 순위표의 한 항목입니다
+type GalleryRank struct {
+	Gallery Gallery
+	Rank    int  // 현재 순위
+	Change  int  // 이전 순위 대비 변동 (양수는 상승, 음수는 하락)
+	New     bool // 순위표에 새로 진입했는지 여부
+}
+
+var (
+	galleryRankingEndpoints = map[GalleryType]string{
+		Minor: "https://json2.dcinside.com/json1/mgallmain/mgallery_ranking.php",
+		Mini:  "https://json2.dcinside.com/json1/minigall/minigall_ranking.php",
+	}
+)
+
+// Ranking 메소드는 마이너 또는 미니 갤러리 순위표를 불러옵니다
+func (session *Session) Ranking(t GalleryType) ([]GalleryRank, error) {
+	endpoint, ok := galleryRankingEndpoints[t]
+	if !ok {
+		return nil, errors.WithMessage(ErrNotSupported, "순위표를 제공하지 않습니다")
+	}
+
+	res, err := session.Client.R().
+		SetHeader("Referer", "https://gall.dcinside.com/").
+		Get(endpoint)
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 순위표 요청 중 오류가 발생했습니다")
+	}
+
+	// JSONP 형식으로 반환될 경우 콜백 함수 호출 부분 제거하기
+	body := strings.TrimSpace(res.String())
+	if i := strings.Index(body, "("); i >= 0 && !strings.HasPrefix(body, "[") {
+		body = strings.TrimSuffix(strings.TrimSuffix(body[i+1:], ";"), ")")
+	}
+
+	var items []struct {
+		ID       string `json:"id"`
+		Name     string `json:"ko_name"`
+		Rank     string `json:"rank"`
+		RankType string `json:"rank_type"`
+		RankDiff string `json:"rank_ex"`
+	}
+
+	if err := json.Unmarshal([]byte(body), &items); err != nil {
+		return nil, errors.WithMessage(err, "갤러리 순위표 파싱 중 오류가 발생했습니다")
+	}
+
+	ranks := make([]GalleryRank, 0, len(items))
+
+	for _, item := range items {
+		rank := GalleryRank{
+			Gallery: Gallery{
+				session: session,
+				ID:      item.ID,
+				Name:    item.Name,
+				Type:    t,
+			},
+		}
+
+		rank.Rank, _ = strconv.Atoi(item.Rank)
+		diff, _ := strconv.Atoi(item.RankDiff)
+
+		switch item.RankType {
+		case "up":
+			rank.Change = diff
+		case "down":
+			rank.Change = -diff
+		case "new":
+			rank.New = true
+		}
+
+		ranks = append(ranks, rank)
+	}
+
+	return ranks, nil
+}
@@ -0,0 +1,148 @@
+package dc
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrGalleryClosed = errors.New("폐쇄된 갤러리입니다")
+	ErrAdultGallery  = errors.New("성인 인증이 필요한 갤러리입니다")
+
+	patternLocationReplace = regexp.MustCompile(`location\.(?:replace\(|href\s*=\s*)['"]([^'"]+)['"]`)
+)
+
+// GalleryResolution 은 갤러리 아이디로 확인한 갤러리 종류입니다
+type GalleryResolution struct {
+	ID    string
+	Type  GalleryType
+	Moved bool // 메이저 갤러리 주소가 마이너 갤러리로 이동됐는지 여부
+}
+
+// GalleryResolver 는 갤러리 아이디만으로 갤러리 종류를 확인하고 결과를 보관합니다
+type GalleryResolver struct {
+	session *Session
+
+	mutex sync.Mutex
+	cache map[string]GalleryResolution
+}
+
+func (session *Session) NewGalleryResolver() *GalleryResolver {
+	return &GalleryResolver{
+		session: session,
+		cache:   map[string]GalleryResolution{},
+	}
+}
+
+// gateway 메소드는 리다이렉션을 따라가지 않고 주소를 요청합니다
+//
+// 리다이렉션 응답은 내용이 비어있으므로 세션 클라이언트의 응답 검사를 거치지 않는 별도 클라이언트를 사용합니다
+func (session *Session) gateway(endpoint string) (*resty.Response, error) {
+	client := *session.Client.GetClient()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return resty.NewWithClient(&client).R().Get(endpoint)
+}
+
+// Resolve 메소드는 메이저, 마이너, 미니, 인물 갤러리 주소를 차례대로 요청해 갤러리 종류를 확인합니다
+//
+// 요청 중에는 보관소를 잠그지 않으므로 같은 아이디를 동시에 확인하면 요청이 중복될 수 있습니다
+func (resolver *GalleryResolver) Resolve(id string) (GalleryResolution, error) {
+	resolver.mutex.Lock()
+	resolution, ok := resolver.cache[id]
+	resolver.mutex.Unlock()
+
+	if ok {
+		return resolution, nil
+	}
+
+	for _, t := range []GalleryType{Major, Minor, Mini, Person} {
+		res, err := resolver.session.gateway(galleryEndpoints[t] + "/lists/?id=" + url.QueryEscape(id))
+		if err != nil {
+			return GalleryResolution{}, errors.WithMessage(err, "갤러리 게이트웨이 페이지를 요청하는 중 오류가 발생했습니다")
+		}
+
+		body := res.String()
+
+		// 다른 종류의 갤러리 주소로 이동시킨다면 이동된 갤러리 종류 사용하기
+		location := res.Header().Get("Location")
+		if matches := patternLocationReplace.FindStringSubmatch(body); location == "" && len(matches) > 1 {
+			location = matches[1]
+		}
+
+		if location != "" {
+			if u, err := url.Parse(location); err == nil && u.Query().Get("id") == id {
+				if moved, ok := galleryTypeFromPath(u.Path); ok && moved != t {
+					return resolver.store(GalleryResolution{
+						ID:    id,
+						Type:  moved,
+						Moved: t == Major && moved == Minor,
+					}), nil
+				}
+			}
+		}
+
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+		if err != nil {
+			return GalleryResolution{}, errors.WithMessage(err, "갤러리 게이트웨이 페이지 파싱 중 오류가 발생했습니다")
+		}
+
+		adult, closed := galleryNotice(doc)
+
+		switch {
+		case closed:
+			return GalleryResolution{}, ErrGalleryClosed
+		case adult:
+			return GalleryResolution{}, ErrAdultGallery
+		case res.StatusCode() == 200 && doc.Find(".gall_list").Length() > 0:
+			return resolver.store(GalleryResolution{ID: id, Type: t}), nil
+		}
+	}
+
+	return GalleryResolution{}, ErrNotFound
+}
+
+func (resolver *GalleryResolver) store(resolution GalleryResolution) GalleryResolution {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	resolver.cache[resolution.ID] = resolution
+	return resolution
+}
+
+// Forget 메소드는 보관된 갤러리 종류를 지워 다음 확인 때 서버에서 다시 확인하도록 합니다
+func (resolver *GalleryResolver) Forget(id string) {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	delete(resolver.cache, id)
+}
+
+// Gallery 메소드는 갤러리 종류를 확인한 뒤 갤러리 정보를 불러옵니다
+func (resolver *GalleryResolver) Gallery(id string) (*Gallery, error) {
+	resolution, err := resolver.Resolve(id)
+	if err != nil {
+		return nil, err
+	}
+
+	gallery := &Gallery{
+		session: resolver.session,
+		ID:      id,
+		Type:    resolution.Type,
+	}
+
+	if err := gallery.load(galleryEndpoints[gallery.Type] + "/lists/?id=" + url.QueryEscape(id)); err != nil {
+		return nil, err
+	}
+
+	return gallery, nil
+}
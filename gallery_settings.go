@@ -0,0 +1,184 @@
+package dc
+
+import (
+	"bytes"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+// GallerySettings 는 마이너 또는 미니 갤러리의 관리 설정입니다
+type GallerySettings struct {
+	Description   string        `json:"description"`
+	Icon          string        `json:"icon,omitempty"` // 현재 아이콘 주소 (읽기 전용)
+	IconImage     []byte        `json:"-"`              // 새로 올릴 아이콘 이미지, 비어있으면 변경하지 않음
+	WordFilters   []string      `json:"wordFilters"`
+	MembersOnly   bool          `json:"membersOnly"`   // 회원만 글쓰기 허용
+	MinAccountAge int           `json:"minAccountAge"` // 글쓰기에 필요한 최소 가입 일수
+	Heads         []ArticleHead `json:"heads"`
+}
+
+// 설정 항목 이름
+const (
+	SettingDescription  = "description"
+	SettingIcon         = "icon"
+	SettingWordFilters  = "wordFilters"
+	SettingRestrictions = "restrictions"
+	SettingHeads        = "heads"
+)
+
+// Settings 메소드는 갤러리 관리 설정을 불러옵니다
+func (gallery *Gallery) Settings() (*GallerySettings, error) {
+	if _, err := gallery.authorize(Manager); err != nil {
+		return nil, err
+	}
+
+	res, err := gallery.session.Client.R().
+		SetQueryParam("id", gallery.ID).
+		Get(managementEndpoints[gallery.Type] + "/")
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 관리 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 관리 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	settings := &GallerySettings{
+		Description: strings.TrimSpace(doc.Find("textarea#gall_intro").Text()),
+		Icon:        doc.Find(".gall_icon img").AttrOr("src", ""),
+		WordFilters: []string{},
+		Heads:       []ArticleHead{},
+	}
+
+	for _, word := range strings.Split(doc.Find("textarea#filter_word").Text(), ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			settings.WordFilters = append(settings.WordFilters, word)
+		}
+	}
+
+	settings.MembersOnly = doc.Find("input[name=member_only]:checked").AttrOr("value", "") == "1"
+	settings.MinAccountAge, _ = strconv.Atoi(doc.Find("select[name=join_day] option[selected]").AttrOr("value", "0"))
+
+	doc.Find(".headtext_list li").Each(func(_ int, s *goquery.Selection) {
+		id, _ := strconv.Atoi(s.AttrOr("data-no", ""))
+		settings.Heads = append(settings.Heads, ArticleHead{
+			ID:   id,
+			Name: strings.TrimSpace(s.Find("input").AttrOr("value", "")),
+		})
+	})
+
+	return settings, nil
+}
+
+// Diff 메소드는 현재 설정과 target 설정을 비교해 달라진 설정 항목 이름을 반환합니다
+func (settings GallerySettings) Diff(target GallerySettings) []string {
+	changes := []string{}
+
+	if settings.Description != target.Description {
+		changes = append(changes, SettingDescription)
+	}
+
+	if len(target.IconImage) > 0 {
+		changes = append(changes, SettingIcon)
+	}
+
+	if strings.Join(settings.WordFilters, ",") != strings.Join(target.WordFilters, ",") {
+		changes = append(changes, SettingWordFilters)
+	}
+
+	if settings.MembersOnly != target.MembersOnly || settings.MinAccountAge != target.MinAccountAge {
+		changes = append(changes, SettingRestrictions)
+	}
+
+	if !(len(settings.Heads) == 0 && len(target.Heads) == 0) && !reflect.DeepEqual(settings.Heads, target.Heads) {
+		changes = append(changes, SettingHeads)
+	}
+
+	return changes
+}
+
+// ApplySettings 메소드는 현재 갤러리 설정을 불러와 target 설정과 달라진 항목만 변경하고 변경한 설정 항목 이름을 반환합니다
+//
+// 도중에 변경이 실패하면 그 전까지 변경한 설정 항목 이름을 오류와 함께 반환합니다
+func (gallery *Gallery) ApplySettings(target GallerySettings) ([]string, error) {
+	current, err := gallery.Settings()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := current.Diff(target)
+	applied := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		payload := url.Values{}
+
+		switch change {
+		case SettingDescription:
+			payload.Set("gall_intro", target.Description)
+			err = gallery.manage(Manager, "update_description", payload)
+
+		case SettingIcon:
+			err = gallery.uploadIcon(target.IconImage)
+
+		case SettingWordFilters:
+			payload.Set("filter_word", strings.Join(target.WordFilters, ","))
+			err = gallery.manage(Manager, "update_filter", payload)
+
+		case SettingRestrictions:
+			payload.Set("member_only", "0")
+			if target.MembersOnly {
+				payload.Set("member_only", "1")
+			}
+			payload.Set("join_day", strconv.Itoa(target.MinAccountAge))
+			err = gallery.manage(Manager, "update_restriction", payload)
+
+		case SettingHeads:
+			for _, head := range target.Heads {
+				payload.Add("headtext_no[]", strconv.Itoa(head.ID))
+				payload.Add("headtext_name[]", head.Name)
+			}
+			err = gallery.manage(Manager, "update_headtext_list", payload)
+		}
+
+		if err != nil {
+			return applied, errors.WithMessagef(err, "갤러리 설정 변경 중 오류가 발생했습니다: %s", change)
+		}
+
+		applied = append(applied, change)
+
+		// 말머리 목록이 바뀌었다면 갤러리 구조에도 반영하기
+		if change == SettingHeads {
+			gallery.Heads = target.Heads
+		}
+	}
+
+	return applied, nil
+}
+
+// uploadIcon 메소드는 갤러리 아이콘 이미지를 업로드합니다
+func (gallery *Gallery) uploadIcon(image []byte) error {
+	if _, err := gallery.authorize(Manager); err != nil {
+		return err
+	}
+
+	_, err := gallery.session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(H{
+			"ci_t":       gallery.session.token(),
+			"id":         gallery.ID,
+			"_GALLTYPE_": gallery.Type.code(),
+		}).
+		SetFileReader("upload", "icon.png", bytes.NewReader(image)).
+		Post("https://gall.dcinside.com/ajax/managements_ajax/upload_icon")
+	if err != nil {
+		return errors.WithMessage(err, "갤러리 아이콘 업로드 요청 중 오류가 발생했습니다")
+	}
+
+	return nil
+}
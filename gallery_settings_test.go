@@ -0,0 +1,30 @@
+package dc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/toriato/dc"
+)
+
+func TestGallerySettingsDiff(t *testing.T) {
+	current := dc.GallerySettings{
+		Description: "소개",
+		WordFilters: []string{"광고"},
+		Heads:       []dc.ArticleHead{{ID: 10, Name: "일반"}},
+	}
+
+	// 같은 설정이라면 변경 사항이 없어야함
+	assert.Empty(t, current.Diff(current))
+
+	target := current
+	target.Description = "새 소개"
+	target.MinAccountAge = 7
+	target.Heads = []dc.ArticleHead{{ID: 10, Name: "일반"}, {ID: 20, Name: "질문"}}
+
+	assert.Equal(t, []string{
+		dc.SettingDescription,
+		dc.SettingRestrictions,
+		dc.SettingHeads,
+	}, current.Diff(target))
+}
@@ -0,0 +1,108 @@
+package dc
+
+import (
+	"bytes"
+	"net/url"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvitationPending  = errors.New("이미 부매니저 초대를 보낸 사용자입니다")
+	ErrInvitationRejected = errors.New("부매니저 초대가 거부됐습니다")
+)
+
+// SubManagers 메소드는 갤러리 관리 페이지에서 부매니저 목록을 불러와 갤러리 구조에 반영합니다
+func (gallery *Gallery) SubManagers() ([]User, error) {
+	if _, err := gallery.authorize(Manager | Moderator); err != nil {
+		return nil, err
+	}
+
+	res, err := gallery.session.Client.R().
+		SetQueryParam("id", gallery.ID).
+		Get(managementEndpoints[gallery.Type] + "/submanager")
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 부매니저 관리 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤러리 부매니저 관리 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	users := []User{}
+
+	doc.Find(".submanager_list li .gall_writer").Each(func(_ int, s *goquery.Selection) {
+		users = append(users, User{
+			Username: s.AttrOr("data-uid", ""),
+			Nickname: s.AttrOr("data-nick", ""),
+			Flags:    Member | Moderator,
+		})
+	})
+
+	gallery.Moderators = users
+
+	return users, nil
+}
+
+// InviteSubManager 메소드는 사용자에게 부매니저 초대를 보냅니다
+//
+// 이미 초대를 보냈다면 ErrInvitationPending, 사용자가 초대를 거부했다면 ErrInvitationRejected 오류를 반환합니다
+func (gallery *Gallery) InviteSubManager(username string) error {
+	payload := url.Values{}
+	payload.Set("user_id", username)
+
+	return gallery.manage(Manager, "invite_submanager", payload)
+}
+
+// RevokeSubManager 메소드는 부매니저를 해임하거나 보낸 초대를 취소합니다
+func (gallery *Gallery) RevokeSubManager(username string) error {
+	payload := url.Values{}
+	payload.Set("user_id", username)
+
+	if err := gallery.manage(Manager, "revoke_submanager", payload); err != nil {
+		return err
+	}
+
+	// 갤러리 구조의 부매니저 목록에서도 제거하기
+	moderators := []User{}
+	for _, moderator := range gallery.Moderators {
+		if moderator.Username != username {
+			moderators = append(moderators, moderator)
+		}
+	}
+	gallery.Moderators = moderators
+
+	return nil
+}
+
+// AcceptSubManager 메소드는 로그인한 사용자가 받은 갤러리의 부매니저 초대를 수락합니다
+func (gallery *Gallery) AcceptSubManager() error {
+	if gallery.Type != Minor && gallery.Type != Mini {
+		return ErrNotSupported
+	}
+
+	user := gallery.session.User
+	if user == nil {
+		return ErrUnauthorized
+	}
+
+	_, err := gallery.session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(H{
+			"ci_t":       gallery.session.token(),
+			"id":         gallery.ID,
+			"_GALLTYPE_": gallery.Type.code(),
+		}).
+		Post("https://gall.dcinside.com/ajax/managements_ajax/accept_submanager")
+	if err != nil {
+		return errors.WithMessage(err, "부매니저 초대 수락 요청 중 오류가 발생했습니다")
+	}
+
+	moderator := *user
+	moderator.Flags.Set(Moderator)
+	gallery.Moderators = append(gallery.Moderators, moderator)
+
+	return nil
+}
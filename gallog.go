@@ -18,6 +18,10 @@ type Gallog struct {
 	User *User
 }
 
+var (
+	ErrGallogPrivate = errors.New("갤로그 주인이 비공개로 설정한 목록입니다")
+)
+
 func (session *Session) NewGallog(username string) *Gallog {
 	return &Gallog{
 		session: session,
@@ -73,7 +77,7 @@ func (gallog *Gallog) Guestbook(year, page int64) ([]GuestbookEntry, error) {
 		entry := GuestbookEntry{
 			gallog:  gallog,
 			User:    &User{},
-			Content: s.Find(".memo").Text(),
+			Content: s.Find(".memo").First().Text(),
 		}
 
 		// 방명록 아이디와 헤드 값
@@ -87,11 +91,11 @@ func (gallog *Gallog) Guestbook(year, page int64) ([]GuestbookEntry, error) {
 		}
 
 		// 작성 시각
-		date, _ := time.Parse("2006.01.02 03:04:05", s.Find(".date").Text())
+		date, _ := time.ParseInLocation(guestbookDateLayout, strings.TrimSpace(s.Find(".date").First().Text()), kst)
 		entry.CreatedAt = date
 
 		// 작성자 정보
-		writerRef := s.Find(".writer_info")
+		writerRef := s.Find(".writer_info").First()
 
 		if ipRef := writerRef.Find(".ip"); ipRef.Length() > 0 {
 			ip := ipRef.Text()
@@ -115,8 +119,73 @@ func (gallog *Gallog) Guestbook(year, page int64) ([]GuestbookEntry, error) {
 
 		entry.User.Nickname = writerRef.Find(".nickname").Text()
 
+		// 갤로그 주인의 답글
+		s.Find(".reply_list > li").Each(func(_ int, r *goquery.Selection) {
+			reply := GuestbookEntry{
+				gallog:  gallog,
+				Head:    entry.Head,
+				User:    gallog.User,
+				Content: r.Find(".memo").Text(),
+			}
+
+			id, _ := strconv.ParseInt(r.AttrOr("data-no", ""), 10, 64)
+			reply.ID = id
+
+			date, _ := time.ParseInLocation(guestbookDateLayout, strings.TrimSpace(r.Find(".date").Text()), kst)
+			reply.CreatedAt = date
+
+			entry.Replies = append(entry.Replies, reply)
+		})
+
 		entries = append(entries, entry)
 	})
 
 	return entries, nil
 }
+
+// page 메소드는 갤로그의 목록 페이지를 불러오고 비공개 목록이라면 ErrGallogPrivate 오류를 반환합니다
+func (gallog *Gallog) page(name string, page int64) (*goquery.Document, error) {
+	res, err := gallog.session.Client.R().
+		SetQueryParam("p", strconv.FormatInt(page, 10)).
+		Get(fmt.Sprintf("https://gallog.dcinside.com/%s/%s", gallog.User.Username, name))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "갤로그 목록 페이지 요청 중 오류가 발생했습니다: %s", name)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "갤로그 목록 페이지 파싱 중 오류가 발생했습니다: %s", name)
+	}
+
+	if doc.Find(".cont_box .private_box").Length() > 0 {
+		return nil, ErrGallogPrivate
+	}
+
+	return doc, nil
+}
+
+// parseListItem 메소드는 갤로그 글 목록의 한 항목에서 원본 게시글 정보를 파싱합니다
+func (gallog *Gallog) parseListItem(s *goquery.Selection) (*Article, bool) {
+	u, err := url.Parse(s.Find("a.link").AttrOr("href", ""))
+	if err != nil {
+		return nil, false
+	}
+
+	article := &Article{
+		Gallery: &Gallery{
+			session: gallog.session,
+			ID:      u.Query().Get("id"),
+			Name:    strings.TrimSpace(s.Find(".gall_name").Text()),
+		},
+		Subject: strings.TrimSpace(s.Find(".tit").Text()),
+	}
+	article.Gallery.Type, _ = galleryTypeFromPath(u.Path)
+
+	id, _ := strconv.ParseInt(u.Query().Get("no"), 10, 64)
+	article.ID = id
+
+	date, _ := time.ParseInLocation("2006.01.02 15:04:05", strings.TrimSpace(s.Find(".date").Text()), kst)
+	article.CreatedAt = date
+
+	return article, true
+}
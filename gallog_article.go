@@ -0,0 +1,32 @@
+package dc
+
+import "github.com/PuerkitoBio/goquery"
+
+// Articles 메소드는 갤로그 주인이 모든 갤러리에 작성한 게시글 목록을 불러옵니다
+//
+// 갤로그 주인이 게시글 목록을 비공개로 설정했다면 ErrGallogPrivate 오류를 반환합니다
+func (gallog *Gallog) Articles(page int64) ([]Article, error) {
+	doc, err := gallog.page("posting", page)
+	if err != nil {
+		return nil, err
+	}
+
+	articles := []Article{}
+
+	doc.Find(".cont_listbox > li").Each(func(_ int, s *goquery.Selection) {
+		article, ok := gallog.parseListItem(s)
+		if !ok {
+			return
+		}
+
+		article.Author = gallog.User
+
+		article.TextComments = parseNumber(s.Find(".reply_num").Text())
+		article.Views = parseNumber(s.Find(".view_num").Text())
+		article.Upvotes = parseNumber(s.Find(".recom_num").Text())
+
+		articles = append(articles, *article)
+	})
+
+	return articles, nil
+}
@@ -0,0 +1,46 @@
+package dc
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Comments 메소드는 갤로그 주인이 모든 갤러리에 작성한 댓글 목록을 불러옵니다
+//
+// 갤로그 주인이 댓글 목록을 비공개로 설정했다면 ErrGallogPrivate 오류를 반환합니다
+func (gallog *Gallog) Comments(page int64) ([]Comment, error) {
+	doc, err := gallog.page("comment", page)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := []Comment{}
+
+	doc.Find(".cont_listbox > li").Each(func(_ int, s *goquery.Selection) {
+		// 댓글이 달린 원본 게시글
+		article, ok := gallog.parseListItem(s)
+		if !ok {
+			return
+		}
+
+		comment := Comment{
+			Article:   article,
+			Author:    gallog.User,
+			Content:   strings.TrimSpace(s.Find(".cont").Text()),
+			CreatedAt: article.CreatedAt,
+		}
+
+		// 목록의 시각은 댓글 작성 시각이므로 원본 게시글에는 설정하지 않기
+		article.CreatedAt = time.Time{}
+
+		id, _ := strconv.ParseInt(s.AttrOr("data-no", ""), 10, 64)
+		comment.ID = id
+
+		comments = append(comments, comment)
+	})
+
+	return comments, nil
+}
@@ -17,8 +17,11 @@ type GuestbookEntry struct {
 	Content   string
 	Secret    bool
 	CreatedAt time.Time
+	Replies   []GuestbookEntry // 갤로그 주인의 답글
 }
 
+const guestbookDateLayout = "2006.01.02 15:04:05"
+
 func (entry GuestbookEntry) Delete() error {
 	if entry.gallog == nil {
 		return nil
@@ -36,3 +39,41 @@ func (entry GuestbookEntry) Delete() error {
 
 	return nil
 }
+
+// Reply 메소드는 갤로그 주인으로서 방명록에 답글을 작성합니다
+func (entry *GuestbookEntry) Reply(content string) error {
+	if entry.gallog == nil {
+		return errors.WithMessage(ErrUnexpected, "방명록이 속한 갤로그 정보가 없습니다")
+	}
+
+	session := entry.gallog.session
+	if session.User == nil {
+		return ErrUnauthorized
+	}
+
+	// 답글은 갤로그 주인만 작성할 수 있음
+	if session.User.Username != entry.gallog.User.Username {
+		return ErrForbidden
+	}
+
+	_, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(map[string]string{
+			"headnum": strconv.FormatInt(entry.Head, 10),
+			"memo":    content,
+		}).
+		Post(fmt.Sprintf("https://gallog.dcinside.com/%s/ajax/guestbook_ajax/reply", entry.gallog.User.Username))
+	if err != nil {
+		return errors.WithMessage(err, "갤로그 방명록 답글 작성 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	entry.Replies = append(entry.Replies, GuestbookEntry{
+		gallog:    entry.gallog,
+		Head:      entry.Head,
+		User:      entry.gallog.User,
+		Content:   content,
+		CreatedAt: time.Now(),
+	})
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package dc
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+// GallogProfile 은 갤로그 메인 페이지에 표시되는 사용자 정보입니다
+type GallogProfile struct {
+	Intro         string // 소개글
+	Image         string // 프로필 이미지 주소
+	Visitors      int    // 전체 방문자 수
+	TodayVisitors int    // 오늘 방문자 수
+	Articles      int    // 작성한 게시글 수
+	Comments      int    // 작성한 댓글 수
+	JoinedAt      time.Time
+}
+
+// Profile 메소드는 갤로그 사용자 정보를 불러오고 갤로그 구조의 사용자 닉네임과 플래그를 갱신합니다
+//
+// 존재하지 않는 사용자라면 사용자의 Member 플래그를 지우고 ErrNotFound 오류를 반환합니다
+func (gallog *Gallog) Profile() (*GallogProfile, error) {
+	res, err := gallog.session.Client.R().
+		Get(fmt.Sprintf("https://gallog.dcinside.com/%s", gallog.User.Username))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤로그 메인 페이지 요청 중 오류가 발생했습니다")
+	}
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body()))
+	if err != nil {
+		return nil, errors.WithMessage(err, "갤로그 메인 페이지 파싱 중 오류가 발생했습니다")
+	}
+
+	headRef := doc.Find(".gallog_head")
+
+	// 닉네임이 없다면 존재하지 않는 사용자의 갤로그
+	nickname := strings.TrimSpace(headRef.Find(".nick_name").Text())
+	if nickname == "" {
+		gallog.User.Flags.Clear(Member)
+		return nil, ErrNotFound
+	}
+
+	gallog.User.Nickname = nickname
+	gallog.User.Flags.Set(Member)
+
+	// 닉네임 옆에 붙는 아이콘으로 고닉인지 반고닉인지 확인하기
+	if strings.Contains(headRef.Find(".writer_nikcon img").AttrOr("src", ""), "fix") {
+		gallog.User.Flags.Set(Fixed)
+	} else {
+		gallog.User.Flags.Clear(Fixed)
+	}
+
+	profile := &GallogProfile{
+		Intro:         strings.TrimSpace(doc.Find(".intro_txt").Text()),
+		Image:         doc.Find(".profile_img img").AttrOr("src", ""),
+		Visitors:      parseNumber(doc.Find(".visit_total").Text()),
+		TodayVisitors: parseNumber(doc.Find(".visit_today").Text()),
+		Articles:      parseNumber(doc.Find(".posting_num").Text()),
+		Comments:      parseNumber(doc.Find(".comment_num").Text()),
+	}
+
+	if matches := patternDate.FindStringSubmatch(doc.Find(".join_date").Text()); len(matches) > 0 {
+		date, _ := time.ParseInLocation("2006-01-02", strings.Join(matches[1:], "-"), kst)
+		profile.JoinedAt = date
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,53 @@
+package dc
+
+import (
+	"strconv"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/pkg/errors"
+)
+
+// Scrap 메소드는 게시글을 로그인한 사용자의 갤로그에 스크랩합니다
+func (article Article) Scrap() error {
+	if article.Gallery == nil || article.Gallery.session == nil {
+		return errors.WithMessage(ErrUnexpected, "세션과 연결된 갤러리 정보가 없습니다")
+	}
+
+	session := article.Gallery.session
+	if session.User == nil {
+		return ErrUnauthorized
+	}
+
+	_, err := session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(H{
+			"ci_t":       session.token(),
+			"id":         article.Gallery.ID,
+			"no":         strconv.FormatInt(article.ID, 10),
+			"_GALLTYPE_": article.Gallery.Type.code(),
+		}).
+		Post("https://gall.dcinside.com/ajax/scrap_ajax/scrap")
+	if err != nil {
+		return errors.WithMessage(err, "게시글 스크랩 요청 중 오류가 발생했습니다")
+	}
+
+	return nil
+}
+
+// Scraps 메소드는 갤로그에 스크랩된 게시글 목록을 불러옵니다
+func (gallog *Gallog) Scraps(page int64) ([]Article, error) {
+	doc, err := gallog.page("scrap", page)
+	if err != nil {
+		return nil, err
+	}
+
+	articles := []Article{}
+
+	doc.Find(".cont_listbox > li").Each(func(_ int, s *goquery.Selection) {
+		if article, ok := gallog.parseListItem(s); ok {
+			articles = append(articles, *article)
+		}
+	})
+
+	return articles, nil
+}
@@ -25,6 +25,36 @@ func TestGallogGuestbook(t *testing.T) {
 	}
 }
 
+func TestGallogGuestbookReply(t *testing.T) {
+	session := dc.NewSession()
+
+	if err := session.Login(&testdata.Gallog.Guestbook.Credentials); err != nil {
+		assert.Fail(t, "", err)
+		return
+	}
+
+	gallog := session.NewGallog(session.User.Username)
+	entries, err := gallog.Guestbook(int64(time.Now().Year()), 1)
+	assert.NoError(t, err)
+
+	if len(entries) < 1 {
+		t.Skip("no guestbook entries to reply")
+	}
+
+	entry := entries[0]
+	assert.NoError(t, entry.Reply("감사합니다"))
+
+	// 답글을 단 방명록을 다시 불러오면 답글이 포함되어 있어야함
+	entries, err = gallog.Guestbook(int64(time.Now().Year()), 1)
+	assert.NoError(t, err)
+
+	for _, e := range entries {
+		if e.Head == entry.Head {
+			assert.NotEmpty(t, e.Replies)
+		}
+	}
+}
+
 func TestGallogGuestbookDelete(t *testing.T) {
 	session := dc.NewSession()
 
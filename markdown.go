@@ -0,0 +1,303 @@
+package dc
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	nethtml "golang.org/x/net/html"
+)
+
+var (
+	// 디시인사이드 에디터가 유지하는 태그와 태그별 허용 속성 목록
+	allowedTags = map[string][]string{
+		"p":          {"style"},
+		"div":        {"style"},
+		"span":       {"style"},
+		"br":         nil,
+		"hr":         nil,
+		"b":          nil,
+		"strong":     nil,
+		"i":          nil,
+		"em":         nil,
+		"u":          nil,
+		"s":          nil,
+		"strike":     nil,
+		"a":          {"href", "target"},
+		"img":        {"src", "alt"},
+		"ul":         nil,
+		"ol":         nil,
+		"li":         nil,
+		"blockquote": nil,
+		"pre":        nil,
+		"code":       nil,
+	}
+
+	// 내용까지 통째로 제거되는 태그 목록
+	strippedTags = map[string]bool{
+		"script":   true,
+		"style":    true,
+		"iframe":   true,
+		"object":   true,
+		"embed":    true,
+		"noscript": true,
+		"textarea": true,
+		"head":     true,
+	}
+
+	// 에디터에 제목 태그가 없으므로 글자 크기로 대신하기
+	headingSizes = []string{"24pt", "18pt", "14pt", "12pt", "10pt", "10pt"}
+
+	patternMarkdownHeading = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	patternMarkdownList    = regexp.MustCompile(`^\s*([-*+]|\d+\.)\s+(.*)$`)
+	patternMarkdownRule    = regexp.MustCompile(`^(-\s*){3,}$|^(\*\s*){3,}$|^(_\s*){3,}$`)
+	patternMarkdownCode    = regexp.MustCompile("`([^`]+)`")
+	patternMarkdownSpan    = regexp.MustCompile(`(!?)\[([^\]]*)\]\(((?:[^()\s]|\([^()\s]*\))+)\)`) // 링크 또는 이미지, 주소 안의 괄호 한 단계 허용
+	patternMarkdownBold    = regexp.MustCompile(`\*\*(.+?)\*\*|__(.+?)__`)
+	patternMarkdownItalic  = regexp.MustCompile(`\*([^*]+)\*|\b_([^_]+)_\b`)
+	patternMarkdownStrike  = regexp.MustCompile(`~~(.+?)~~`)
+)
+
+// Markdown 함수는 마크다운 문서를 디시인사이드 에디터가 그대로 유지하는 HTML 로 변환합니다
+//
+// 주소가 없는 이미지 (예: ![](cat.png)) 는 같은 이름의 첨부 파일을 참조하는 것으로 간주합니다
+func Markdown(src string) string {
+	out := strings.Builder{}
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+
+		// 코드 블록
+		case strings.HasPrefix(trimmed, "```"):
+			code := []string{}
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, html.EscapeString(lines[i]))
+			}
+			i++
+
+			fmt.Fprintf(&out, "<pre><code>%s</code></pre>", strings.Join(code, "\n"))
+
+		// 제목
+		case patternMarkdownHeading.MatchString(trimmed):
+			matches := patternMarkdownHeading.FindStringSubmatch(trimmed)
+			fmt.Fprintf(&out, `<p><b><span style="font-size: %s;">%s</span></b></p>`, headingSizes[len(matches[1])-1], markdownInline(matches[2]))
+			i++
+
+		// 구분선
+		case patternMarkdownRule.MatchString(trimmed):
+			out.WriteString("<hr>")
+			i++
+
+		// 인용문
+		case strings.HasPrefix(trimmed, ">"):
+			quote := []string{}
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				quote = append(quote, strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(lines[i]), ">"), " "))
+			}
+
+			fmt.Fprintf(&out, "<blockquote>%s</blockquote>", Markdown(strings.Join(quote, "\n")))
+
+		// 목록
+		case patternMarkdownList.MatchString(line):
+			tag := "ul"
+			if !strings.ContainsAny(patternMarkdownList.FindStringSubmatch(line)[1], "-*+") {
+				tag = "ol"
+			}
+
+			out.WriteString("<" + tag + ">")
+			for ; i < len(lines) && patternMarkdownList.MatchString(lines[i]); i++ {
+				fmt.Fprintf(&out, "<li>%s</li>", markdownInline(patternMarkdownList.FindStringSubmatch(lines[i])[2]))
+			}
+			out.WriteString("</" + tag + ">")
+
+		// 문단
+		default:
+			paragraph := []string{}
+			for ; i < len(lines); i++ {
+				t := strings.TrimSpace(lines[i])
+				if t == "" ||
+					strings.HasPrefix(t, "```") ||
+					strings.HasPrefix(t, ">") ||
+					patternMarkdownHeading.MatchString(t) ||
+					patternMarkdownRule.MatchString(t) ||
+					patternMarkdownList.MatchString(lines[i]) {
+					break
+				}
+
+				paragraph = append(paragraph, markdownInline(t))
+			}
+
+			fmt.Fprintf(&out, "<p>%s</p>", strings.Join(paragraph, "<br>"))
+		}
+	}
+
+	return Sanitize(out.String())
+}
+
+// markdownInline 함수는 한 줄 안의 마크다운 강조, 링크, 이미지, 코드를 HTML 로 변환합니다
+func markdownInline(text string) string {
+	out := strings.Builder{}
+
+	// 코드 안의 문자열은 변환하지 않도록 코드를 기준으로 나눠 처리하기
+	last := 0
+	for _, loc := range patternMarkdownCode.FindAllStringSubmatchIndex(text, -1) {
+		out.WriteString(markdownEmphasis(text[last:loc[0]]))
+		out.WriteString("<code>" + html.EscapeString(text[loc[2]:loc[3]]) + "</code>")
+		last = loc[1]
+	}
+	out.WriteString(markdownEmphasis(text[last:]))
+
+	return out.String()
+}
+
+// markdownEmphasis 함수는 링크와 이미지를 자리 표시자로 바꿔둔 뒤 강조를 적용해 주소가 바뀌지 않도록 합니다
+func markdownEmphasis(text string) string {
+	spans := []string{}
+
+	text = patternMarkdownSpan.ReplaceAllStringFunc(text, func(s string) string {
+		matches := patternMarkdownSpan.FindStringSubmatch(s)
+		image, label, src := matches[1] != "", matches[2], matches[3]
+
+		switch {
+		case image:
+			if !strings.Contains(src, "://") {
+				src = AttachmentScheme + src
+			}
+
+			s = fmt.Sprintf(`<img src="%s" alt="%s">`, html.EscapeString(src), html.EscapeString(label))
+		case label == "":
+			return s
+		default:
+			s = fmt.Sprintf(`<a href="%s" target="_blank">%s</a>`, html.EscapeString(src), markdownStyle(label))
+		}
+
+		spans = append(spans, s)
+		return fmt.Sprintf("\x00%d\x00", len(spans)-1)
+	})
+
+	text = markdownStyle(text)
+
+	for i, span := range spans {
+		text = strings.Replace(text, fmt.Sprintf("\x00%d\x00", i), span, 1)
+	}
+
+	return text
+}
+
+// markdownStyle 함수는 문자열을 이스케이프한 뒤 굵게, 기울임, 취소선을 적용합니다
+func markdownStyle(text string) string {
+	text = html.EscapeString(text)
+	text = patternMarkdownBold.ReplaceAllString(text, "<b>$1$2</b>")
+	text = patternMarkdownItalic.ReplaceAllString(text, "<i>$1$2</i>")
+	text = patternMarkdownStrike.ReplaceAllString(text, "<s>$1</s>")
+
+	return text
+}
+
+// Sanitize 함수는 HTML 에서 디시인사이드 에디터가 제거하는 태그와 속성을 미리 제거합니다
+func Sanitize(src string) string {
+	out := strings.Builder{}
+	tokenizer := nethtml.NewTokenizer(strings.NewReader(src))
+
+	// 내용까지 제거하는 태그 안에 있는 깊이
+	skip := 0
+
+	for {
+		t := tokenizer.Next()
+		if t == nethtml.ErrorToken {
+			break
+		}
+
+		token := tokenizer.Token()
+
+		switch t {
+		case nethtml.TextToken:
+			if skip == 0 {
+				out.WriteString(html.EscapeString(token.Data))
+			}
+
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			if strippedTags[token.Data] {
+				if t == nethtml.StartTagToken {
+					skip++
+				}
+				continue
+			}
+
+			attrs, ok := allowedTags[token.Data]
+			if !ok || skip > 0 {
+				continue
+			}
+
+			out.WriteString("<" + token.Data)
+			for _, attr := range token.Attr {
+				if !allowedAttribute(token.Data, attrs, attr) {
+					continue
+				}
+
+				fmt.Fprintf(&out, ` %s="%s"`, attr.Key, html.EscapeString(attr.Val))
+			}
+			out.WriteString(">")
+
+		case nethtml.EndTagToken:
+			if strippedTags[token.Data] {
+				if skip > 0 {
+					skip--
+				}
+				continue
+			}
+
+			if _, ok := allowedTags[token.Data]; !ok || skip > 0 {
+				continue
+			}
+
+			switch token.Data {
+			case "br", "hr", "img":
+				continue
+			}
+
+			out.WriteString("</" + token.Data + ">")
+		}
+	}
+
+	return out.String()
+}
+
+// allowedAttribute 함수는 태그의 속성이 에디터에서 유지되는 안전한 속성인지 확인합니다
+func allowedAttribute(tag string, allowed []string, attr nethtml.Attribute) bool {
+	found := false
+	for _, key := range allowed {
+		if attr.Key == key {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return false
+	}
+
+	value := strings.ToLower(strings.TrimSpace(attr.Val))
+
+	switch attr.Key {
+	case "href":
+		return strings.HasPrefix(value, "http://") ||
+			strings.HasPrefix(value, "https://") ||
+			strings.HasPrefix(value, "mailto:")
+	case "src":
+		return strings.HasPrefix(value, "http://") ||
+			strings.HasPrefix(value, "https://") ||
+			(tag == "img" && strings.HasPrefix(value, AttachmentScheme))
+	case "style":
+		return !strings.Contains(value, "expression") && !strings.Contains(value, "url(")
+	}
+
+	return true
+}
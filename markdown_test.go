@@ -0,0 +1,46 @@
+package dc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/toriato/dc"
+)
+
+func TestMarkdown(t *testing.T) {
+	cases := map[string]string{
+		"# 변경 사항":                                     `<p><b><span style="font-size: 24pt;">변경 사항</span></b></p>`,
+		"**굵게** *기울임* ~~취소~~ `a*b*`":                  `<p><b>굵게</b> <i>기울임</i> <s>취소</s> <code>a*b*</code></p>`,
+		"첫 줄\n둘째 줄\n\n새 문단":                           `<p>첫 줄<br>둘째 줄</p><p>새 문단</p>`,
+		"- 하나\n- 둘":                                   `<ul><li>하나</li><li>둘</li></ul>`,
+		"1. 하나\n2. 둘":                                 `<ol><li>하나</li><li>둘</li></ol>`,
+		"[링크](https://dcinside.com)":                  `<p><a href="https://dcinside.com" target="_blank">링크</a></p>`,
+		"[위험](javascript:alert(1))":                   `<p><a target="_blank">위험</a></p>`,
+		"[a](https://x.com/__init__.py)":              `<p><a href="https://x.com/__init__.py" target="_blank">a</a></p>`,
+		"[위키](https://ko.wikipedia.org/wiki/Go_(언어))": `<p><a href="https://ko.wikipedia.org/wiki/Go_(언어)" target="_blank">위키</a></p>`,
+		"**[굵은 링크](https://a.com/*x*)**":              `<p><b><a href="https://a.com/*x*" target="_blank">굵은 링크</a></b></p>`,
+		"![](my__cat**.png)":                          `<p><img src="attachment:my__cat**.png" alt=""></p>`,
+		"![고양이](cat.png)":                             `<p><img src="attachment:cat.png" alt="고양이"></p>`,
+		"```\n<b>코드</b>\n```":                         `<pre><code>&lt;b&gt;코드&lt;/b&gt;</code></pre>`,
+		"> 인용":                                        `<blockquote><p>인용</p></blockquote>`,
+		"<script>alert(1)</script>":                   `<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>`,
+	}
+
+	for src, expected := range cases {
+		assert.Equal(t, expected, dc.Markdown(src), src)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	cases := map[string]string{
+		`<p onclick="x()">내용</p>`:                    `<p>내용</p>`,
+		`<script>alert(1)</script><b>굵게</b>`:         `<b>굵게</b>`,
+		`<h1>제목</h1>`:                                `제목`,
+		`<img src="javascript:x" alt="a"><br/>`:      `<img alt="a"><br>`,
+		`<span style="background: url(x)">글자</span>`: `<span>글자</span>`,
+	}
+
+	for src, expected := range cases {
+		assert.Equal(t, expected, dc.Sanitize(src), src)
+	}
+}
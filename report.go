@@ -0,0 +1,84 @@
+package dc
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ReportCategory 는 디시인사이드 신고 창에서 선택할 수 있는 신고 사유 분류입니다
+type ReportCategory int
+
+const (
+	ReportObscene       ReportCategory = iota + 1 // 음란물
+	ReportAdvertisement                           // 광고/홍보
+	ReportAbuse                                   // 욕설/비하
+	ReportPrivacy                                 // 개인정보 노출
+	ReportCopyright                               // 저작권 침해
+	ReportFlooding                                // 도배
+	ReportIllegal                                 // 불법 정보
+	ReportOther                                   // 기타
+)
+
+// ReportResult 는 신고 요청에 대한 서버의 처리 결과입니다
+type ReportResult int
+
+const (
+	ReportUnknown    ReportResult = iota // 오류로 처리 결과를 알 수 없음
+	ReportAccepted                       // 신고 접수됨
+	ReportDuplicated                     // 이미 신고한 대상
+	ReportForbidden                      // 신고할 수 없는 대상이거나 권한 없음
+)
+
+var (
+	ErrAlreadyReported = errors.New("이미 신고한 대상입니다")
+)
+
+// Report 메소드는 게시글을 주어진 분류와 사유로 신고합니다
+func (article Article) Report(category ReportCategory, reason string) (ReportResult, error) {
+	return report(article.Gallery, article.ID, 0, category, reason)
+}
+
+// Report 메소드는 댓글을 주어진 분류와 사유로 신고합니다
+func (comment Comment) Report(category ReportCategory, reason string) (ReportResult, error) {
+	if comment.Article == nil {
+		return ReportUnknown, errors.WithMessage(ErrUnexpected, "댓글이 속한 게시글 정보가 없습니다")
+	}
+
+	return report(comment.Article.Gallery, comment.Article.ID, comment.ID, category, reason)
+}
+
+func report(gallery *Gallery, articleID, commentID int64, category ReportCategory, reason string) (ReportResult, error) {
+	if gallery == nil || gallery.session == nil {
+		return ReportUnknown, errors.WithMessage(ErrUnexpected, "세션과 연결된 갤러리 정보가 없습니다")
+	}
+
+	payload := H{
+		"ci_t":       gallery.session.token(),
+		"id":         gallery.ID,
+		"no":         strconv.FormatInt(articleID, 10),
+		"singo_type": strconv.Itoa(int(category)),
+		"singo_memo": reason,
+		"_GALLTYPE_": gallery.Type.code(),
+	}
+
+	if commentID > 0 {
+		payload["c_no"] = strconv.FormatInt(commentID, 10)
+	}
+
+	_, err := gallery.session.Client.R().
+		SetHeader("X-Requested-With", "XMLHttpRequest").
+		SetFormData(payload).
+		Post("https://gall.dcinside.com/singo/singo_ajax/submit")
+
+	switch {
+	case err == nil:
+		return ReportAccepted, nil
+	case errors.Is(err, ErrAlreadyReported):
+		return ReportDuplicated, nil
+	case errors.Is(err, ErrForbidden):
+		return ReportForbidden, nil
+	}
+
+	return ReportUnknown, errors.WithMessage(err, "신고 요청 중 오류가 발생했습니다")
+}
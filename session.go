@@ -21,6 +21,7 @@ type Session struct {
 	Cookies     *cookiejar.Jar
 	Credentials *Credentials
 	User        *User
+	Vault       PasswordVault // 익명 게시글과 댓글의 비밀번호 저장소 (선택)
 }
 
 type Credentials struct {
@@ -35,6 +36,18 @@ var (
 	ErrInvalidTOTP        = errors.New("TOTP 코드가 잘못됐습니다")
 
 	patternJavascriptAlert = regexp.MustCompile(`alert\((.+)\)`)
+
+	// 서버가 반환한 실패 메세지에 포함된 문구와 대응하는 오류 목록
+	resultErrors = []struct {
+		message string
+		err     error
+	}{
+		{"이미 신고", ErrAlreadyReported},
+		{"권한이 없", ErrForbidden},
+		{"가입 승인", ErrApprovalRequired},
+		{"이미 초대", ErrInvitationPending},
+		{"초대를 거부", ErrInvitationRejected},
+	}
 )
 
 func NewSession() *Session {
@@ -66,6 +79,12 @@ func NewSession() *Session {
 			}
 
 			if result.Status == "fail" {
+				for _, r := range resultErrors {
+					if strings.Contains(result.Message, r.message) {
+						return errors.WithMessage(r.err, result.Message)
+					}
+				}
+
 				return errors.WithMessage(ErrUnexpected, result.Message)
 			}
 		}
@@ -288,6 +307,19 @@ func (session Session) Get() string {
 	return ""
 }
 
+// token 메소드는 갤러리 AJAX 요청에 함께 보내야하는 CSRF 토큰을 반환합니다
+func (session Session) token() string {
+	u, _ := url.Parse("https://gall.dcinside.com")
+
+	for _, c := range session.Cookies.Cookies(u) {
+		if c.Name == "ci_c" {
+			return c.Value
+		}
+	}
+
+	return ""
+}
+
 // Set 메소드는 사용할 세션 아이디를 반환합니다
 func (session *Session) Set(id string) error {
 	u, _ := url.Parse("https://dcinside.com")
@@ -0,0 +1,202 @@
+package dc
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnknownURL = errors.New("디시인사이드 주소가 아니거나 알 수 없는 형식입니다")
+
+	// 모바일 주소의 경로 접두사와 갤러리 종류
+	mobileGalleryPrefixes = map[string]GalleryType{
+		"board":  Major,
+		"mini":   Mini,
+		"person": Person,
+	}
+
+	// 짧은 주소의 경로 접두사와 갤러리 종류
+	shortGalleryPrefixes = map[string]GalleryType{
+		"m":    Minor,
+		"mini": Mini,
+		"p":    Person,
+	}
+
+	// 짧은 주소에서 갤러리 아이디로 볼 수 없는 첫 경로
+	reservedShortSegments = map[string]bool{
+		"board":    true,
+		"mgallery": true,
+		"n":        true,
+		"person":   true,
+	}
+)
+
+// Reference 는 디시인사이드 주소가 가리키는 대상입니다
+type Reference struct {
+	GalleryID   string
+	GalleryType GalleryType
+	ExactType   bool // 주소만으로 갤러리 종류를 확정했는지 여부 (모바일 주소는 메이저와 마이너를 구분하지 않음)
+	ArticleID   int64
+	CommentID   int64
+	Username    string // 갤로그 주소의 사용자 아이디
+}
+
+// ParseURL 함수는 PC, 모바일 갤러리 주소와 갤로그, 이미지 주소를 파싱합니다
+func ParseURL(raw string) (*Reference, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + strings.TrimPrefix(raw, "//")
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, errors.WithMessage(ErrUnknownURL, err.Error())
+	}
+
+	host := strings.ToLower(u.Hostname())
+	query := u.Query()
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+	ref := &Reference{}
+
+	ref.CommentID, _ = strconv.ParseInt(query.Get("c_no"), 10, 64)
+
+	switch {
+	case host == "gallog.dcinside.com":
+		if len(segments) < 1 {
+			return nil, ErrUnknownURL
+		}
+
+		ref.Username = segments[0]
+
+	case host == "m.dcinside.com":
+		if len(segments) < 2 {
+			return nil, ErrUnknownURL
+		}
+
+		if segments[0] == "gallog" {
+			ref.Username = segments[1]
+			break
+		}
+
+		t, ok := mobileGalleryPrefixes[segments[0]]
+		if !ok {
+			return nil, ErrUnknownURL
+		}
+
+		ref.GalleryID = segments[1]
+		ref.GalleryType = t
+		ref.ExactType = t != Major
+
+		if len(segments) > 2 {
+			ref.ArticleID, _ = strconv.ParseInt(segments[2], 10, 64)
+		}
+
+	case host == "dcinside.com" || strings.HasSuffix(host, ".dcinside.com") ||
+		host == "dcinside.co.kr" || strings.HasSuffix(host, ".dcinside.co.kr"):
+		// 이미지 주소는 갤러리 아이디만 알 수 있음
+		// dcimg 주소의 id 값은 갤러리 아이디가 아닌 이미지 코드이므로 image.dcinside.com 에서만 사용함
+		if strings.HasPrefix(u.Path, "/viewimage") {
+			if host != "image.dcinside.com" {
+				return nil, ErrUnknownURL
+			}
+
+			ref.GalleryID = query.Get("id")
+			break
+		}
+
+		// 게시글 목록 또는 게시글 주소
+		if id := query.Get("id"); id != "" {
+			t, ok := galleryTypeFromPath(u.Path)
+			if !ok {
+				return nil, ErrUnknownURL
+			}
+
+			ref.GalleryID = id
+			ref.GalleryType = t
+			ref.ExactType = true
+			ref.ArticleID, _ = strconv.ParseInt(query.Get("no"), 10, 64)
+			break
+		}
+
+		// 짧은 주소 (예: /m/아이디/번호, /아이디/번호) 는 gall.dcinside.com 에서만 사용함
+		if host != "gall.dcinside.com" || len(segments) < 1 || reservedShortSegments[segments[0]] {
+			return nil, ErrUnknownURL
+		}
+
+		ref.GalleryType = Major
+		if t, ok := shortGalleryPrefixes[segments[0]]; ok {
+			// 아이디가 없는 게시판 주소 (예: /mini/board/lists)
+			if len(segments) < 2 || segments[1] == "board" {
+				return nil, ErrUnknownURL
+			}
+
+			ref.GalleryType = t
+			segments = segments[1:]
+		}
+
+		ref.GalleryID = segments[0]
+		ref.ExactType = true
+
+		if len(segments) > 1 {
+			ref.ArticleID, _ = strconv.ParseInt(segments[1], 10, 64)
+		}
+	}
+
+	if ref.GalleryID == "" && ref.Username == "" {
+		return nil, ErrUnknownURL
+	}
+
+	return ref, nil
+}
+
+// URL 메소드는 갤러리 게시글 목록의 PC 주소를 반환합니다
+func (gallery Gallery) URL() string {
+	return fmt.Sprintf("%s/lists/?id=%s", galleryEndpoints[gallery.Type], url.QueryEscape(gallery.ID))
+}
+
+// MobileURL 메소드는 갤러리 게시글 목록의 모바일 주소를 반환합니다
+func (gallery Gallery) MobileURL() string {
+	return "https://m.dcinside.com/" + gallery.mobilePrefix() + "/" + url.PathEscape(gallery.ID)
+}
+
+func (gallery Gallery) mobilePrefix() string {
+	for prefix, t := range mobileGalleryPrefixes {
+		if t == gallery.Type {
+			return prefix
+		}
+	}
+
+	// 모바일에선 마이너 갤러리도 메이저 갤러리와 같은 주소를 사용함
+	return "board"
+}
+
+// URL 메소드는 게시글의 PC 주소를 반환합니다, 갤러리 정보가 없다면 빈 문자열을 반환합니다
+func (article Article) URL() string {
+	if article.Gallery == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/view/?id=%s&no=%d", galleryEndpoints[article.Gallery.Type], url.QueryEscape(article.Gallery.ID), article.ID)
+}
+
+// MobileURL 메소드는 게시글의 모바일 주소를 반환합니다, 갤러리 정보가 없다면 빈 문자열을 반환합니다
+func (article Article) MobileURL() string {
+	if article.Gallery == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%d", article.Gallery.MobileURL(), article.ID)
+}
+
+// URL 메소드는 갤로그의 PC 주소를 반환합니다
+func (gallog Gallog) URL() string {
+	return "https://gallog.dcinside.com/" + url.PathEscape(gallog.User.Username)
+}
+
+// MobileURL 메소드는 갤로그의 모바일 주소를 반환합니다
+func (gallog Gallog) MobileURL() string {
+	return "https://m.dcinside.com/gallog/" + url.PathEscape(gallog.User.Username)
+}
@@ -0,0 +1,90 @@
+package dc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/toriato/dc"
+)
+
+func TestParseURL(t *testing.T) {
+	cases := map[string]dc.Reference{
+		"https://gall.dcinside.com/board/view/?id=programming&no=123": {
+			GalleryID: "programming", GalleryType: dc.Major, ExactType: true, ArticleID: 123,
+		},
+		"https://gall.dcinside.com/mgallery/board/lists?id=github": {
+			GalleryID: "github", GalleryType: dc.Minor, ExactType: true,
+		},
+		"gall.dcinside.com/mini/board/view/?id=test&no=5&c_no=7": {
+			GalleryID: "test", GalleryType: dc.Mini, ExactType: true, ArticleID: 5, CommentID: 7,
+		},
+		"https://gall.dcinside.com/m/github/42": {
+			GalleryID: "github", GalleryType: dc.Minor, ExactType: true, ArticleID: 42,
+		},
+		"https://m.dcinside.com/board/programming/123": {
+			GalleryID: "programming", GalleryType: dc.Major, ArticleID: 123,
+		},
+		"https://m.dcinside.com/person/someone": {
+			GalleryID: "someone", GalleryType: dc.Person, ExactType: true,
+		},
+		"https://gallog.dcinside.com/username/guestbook": {
+			Username: "username",
+		},
+		"https://m.dcinside.com/gallog/username": {
+			Username: "username",
+		},
+		"https://image.dcinside.com/viewimagePop.php?id=programming&no=abc": {
+			GalleryID: "programming",
+		},
+		"https://gall.dcinside.com/mini/test/5": {
+			GalleryID: "test", GalleryType: dc.Mini, ExactType: true, ArticleID: 5,
+		},
+		"https://gall.dcinside.com/programming/123": {
+			GalleryID: "programming", GalleryType: dc.Major, ExactType: true, ArticleID: 123,
+		},
+	}
+
+	for raw, expected := range cases {
+		ref, err := dc.ParseURL(raw)
+		if assert.NoError(t, err, raw) {
+			assert.Equal(t, expected, *ref, raw)
+		}
+	}
+
+	for _, raw := range []string{
+		"https://example.com/board/lists?id=test",
+		"https://gall.dcinside.com/mgallery/board/lists",
+		"https://gall.dcinside.com/m",
+		"https://gall.dcinside.com/n",
+		"https://search.dcinside.com/gallery/q/abc",
+		"https://evil-dcinside.com/foo/1",
+		"https://dcinside.com.evil.net/foo/1",
+		"https://gall.dcinside.com/mini/board/lists",
+		"https://gall.dcinside.com/mini/board/view/?no=3",
+		"https://gall.dcinside.com/person/board/lists",
+		"https://dcimg8.dcinside.co.kr/viewimage.php?id=3dafdf21&no=abc",
+	} {
+		_, err := dc.ParseURL(raw)
+		assert.ErrorIs(t, err, dc.ErrUnknownURL, raw)
+	}
+}
+
+func TestURL(t *testing.T) {
+	gallery := dc.Gallery{ID: "github", Type: dc.Minor}
+	assert.Equal(t, "https://gall.dcinside.com/mgallery/board/lists/?id=github", gallery.URL())
+	assert.Equal(t, "https://m.dcinside.com/board/github", gallery.MobileURL())
+
+	article := dc.Article{ID: 42, Gallery: &gallery}
+	assert.Equal(t, "https://gall.dcinside.com/mgallery/board/view/?id=github&no=42", article.URL())
+	assert.Equal(t, "https://m.dcinside.com/board/github/42", article.MobileURL())
+
+	assert.Empty(t, dc.Article{ID: 42}.URL())
+	assert.Empty(t, dc.Article{ID: 42}.MobileURL())
+
+	// 만든 주소를 다시 파싱하면 같은 대상을 가리켜야함
+	ref, err := dc.ParseURL(article.URL())
+	assert.NoError(t, err)
+	assert.Equal(t, gallery.ID, ref.GalleryID)
+	assert.Equal(t, gallery.Type, ref.GalleryType)
+	assert.Equal(t, article.ID, ref.ArticleID)
+}
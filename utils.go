@@ -5,6 +5,12 @@ import (
 	"strings"
 )
 
+// parseNumber 함수는 문자열에서 처음 나오는 숫자를 쉼표를 제외하고 파싱합니다
+func parseNumber(text string) int {
+	n, _ := strconv.Atoi(strings.ReplaceAll(patternNumber.FindString(text), ",", ""))
+	return n
+}
+
 const decodeKey = "yL/M=zNa0bcPQdReSfTgUhViWjXkYIZmnpo+qArOBslCt2D3uE4Fv5G6wH178xJ9K"
 
 func decode(keys, code string) string {
@@ -0,0 +1,208 @@
+package dc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrVaultNotCleared 는 대상은 삭제됐지만 저장소에서 비밀번호를 지우지 못했을 때 반환하는 오류입니다, 삭제를 다시 시도할 필요는 없습니다
+var ErrVaultNotCleared = errors.New("삭제는 완료됐지만 저장소에서 익명 비밀번호를 지우지 못했습니다")
+
+// PasswordVault 는 익명으로 작성한 게시글과 댓글의 비밀번호를 보관하는 저장소입니다
+//
+// 디시인사이드는 댓글 수정을 지원하지 않으므로 댓글 비밀번호는 삭제할 때만 사용합니다
+// 게시글 키로 Remove 를 호출하면 그 게시글에 달린 댓글의 비밀번호도 함께 지워야합니다
+type PasswordVault interface {
+	Password(key VaultKey) (string, bool)
+	Store(key VaultKey, password string) error
+	Remove(key VaultKey) error
+}
+
+// VaultKey 는 비밀번호를 보관할 대상입니다, 게시글이라면 Comment 값은 0 입니다
+type VaultKey struct {
+	Gallery string
+	Article int64
+	Comment int64
+}
+
+func (key VaultKey) String() string {
+	return fmt.Sprintf("%s/%d/%d", key.Gallery, key.Article, key.Comment)
+}
+
+// MemoryVault 는 프로세스 메모리에만 비밀번호를 보관하는 저장소입니다
+type MemoryVault struct {
+	mutex     sync.RWMutex
+	passwords map[string]string
+}
+
+func NewMemoryVault() *MemoryVault {
+	return &MemoryVault{
+		passwords: map[string]string{},
+	}
+}
+
+func (vault *MemoryVault) Password(key VaultKey) (string, bool) {
+	vault.mutex.RLock()
+	defer vault.mutex.RUnlock()
+
+	password, ok := vault.passwords[key.String()]
+	return password, ok
+}
+
+func (vault *MemoryVault) Store(key VaultKey, password string) error {
+	vault.mutex.Lock()
+	defer vault.mutex.Unlock()
+
+	vault.passwords[key.String()] = password
+	return nil
+}
+
+func (vault *MemoryVault) Remove(key VaultKey) error {
+	vault.mutex.Lock()
+	defer vault.mutex.Unlock()
+
+	vault.remove(key)
+	return nil
+}
+
+// remove 메소드는 비밀번호를 지우고 게시글 키라면 그 게시글에 달린 댓글의 비밀번호도 지웁니다, 호출하는 쪽에서 쓰기 잠금을 잡고 있어야합니다
+func (vault *MemoryVault) remove(key VaultKey) {
+	delete(vault.passwords, key.String())
+
+	if key.Comment != 0 {
+		return
+	}
+
+	prefix := fmt.Sprintf("%s/%d/", key.Gallery, key.Article)
+	for k := range vault.passwords {
+		if strings.HasPrefix(k, prefix) {
+			delete(vault.passwords, k)
+		}
+	}
+}
+
+// FileVault 는 비밀번호를 JSON 파일에 보관하는 저장소입니다
+type FileVault struct {
+	MemoryVault
+
+	path string
+}
+
+// NewFileVault 함수는 주어진 경로의 파일에서 비밀번호를 불러온 저장소를 만듭니다, 파일이 없다면 빈 저장소를 만듭니다
+func NewFileVault(path string) (*FileVault, error) {
+	vault := &FileVault{
+		MemoryVault: MemoryVault{passwords: map[string]string{}},
+		path:        path,
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return vault, nil
+		}
+
+		return nil, errors.WithMessage(err, "비밀번호 저장소 파일을 읽는 중 오류가 발생했습니다")
+	}
+
+	if err := json.Unmarshal(raw, &vault.passwords); err != nil {
+		return nil, errors.WithMessage(err, "비밀번호 저장소 파일 파싱 중 오류가 발생했습니다")
+	}
+
+	return vault, nil
+}
+
+func (vault *FileVault) Store(key VaultKey, password string) error {
+	vault.mutex.Lock()
+	defer vault.mutex.Unlock()
+
+	vault.passwords[key.String()] = password
+	return vault.save()
+}
+
+func (vault *FileVault) Remove(key VaultKey) error {
+	vault.mutex.Lock()
+	defer vault.mutex.Unlock()
+
+	vault.remove(key)
+	return vault.save()
+}
+
+// save 메소드는 비밀번호를 임시 파일에 쓴 뒤 원래 파일과 바꿔치기합니다, 호출하는 쪽에서 쓰기 잠금을 잡고 있어야합니다
+func (vault *FileVault) save() error {
+	raw, err := json.MarshalIndent(vault.passwords, "", "  ")
+	if err != nil {
+		return errors.WithMessage(err, "비밀번호 저장소 직렬화 중 오류가 발생했습니다")
+	}
+
+	// 쓰는 도중 실패해도 기존 파일이 망가지지 않도록 같은 디렉토리에 임시 파일 만들기
+	file, err := ioutil.TempFile(filepath.Dir(vault.path), filepath.Base(vault.path)+".*")
+	if err != nil {
+		return errors.WithMessage(err, "비밀번호 저장소 임시 파일을 만드는 중 오류가 발생했습니다")
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(raw); err != nil {
+		file.Close()
+		return errors.WithMessage(err, "비밀번호 저장소 파일을 쓰는 중 오류가 발생했습니다")
+	}
+
+	if err := file.Close(); err != nil {
+		return errors.WithMessage(err, "비밀번호 저장소 파일을 쓰는 중 오류가 발생했습니다")
+	}
+
+	if err := os.Rename(file.Name(), vault.path); err != nil {
+		return errors.WithMessage(err, "비밀번호 저장소 파일을 바꾸는 중 오류가 발생했습니다")
+	}
+
+	return nil
+}
+
+// remember 메소드는 저장소가 설정되어 있다면 익명 비밀번호를 기록합니다
+func (session *Session) remember(key VaultKey, password string) error {
+	if session.Vault == nil || password == "" {
+		return nil
+	}
+
+	if err := session.Vault.Store(key, password); err != nil {
+		return errors.WithMessage(err, "익명 비밀번호 기록 중 오류가 발생했습니다")
+	}
+
+	return nil
+}
+
+// password 메소드는 사용자에게 비밀번호가 없다면 저장소에서 익명 비밀번호를 찾아 반환합니다
+func (session *Session) password(key VaultKey, user *User) string {
+	if user != nil && user.Password != "" {
+		return user.Password
+	}
+
+	if session.Vault != nil {
+		if password, ok := session.Vault.Password(key); ok {
+			return password
+		}
+	}
+
+	return ""
+}
+
+// forget 메소드는 삭제된 대상의 익명 비밀번호를 저장소에서 지웁니다
+//
+// 대상은 이미 삭제됐으므로 실패하면 다시 삭제를 시도하지 않도록 ErrVaultNotCleared 를 반환합니다
+func (session *Session) forget(key VaultKey) error {
+	if session.Vault == nil {
+		return nil
+	}
+
+	if err := session.Vault.Remove(key); err != nil {
+		return errors.WithMessage(ErrVaultNotCleared, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package dc_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/toriato/dc"
+)
+
+func TestFileVault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.json")
+	key := dc.VaultKey{Gallery: "test", Article: 1, Comment: 2}
+
+	vault, err := dc.NewFileVault(path)
+	assert.NoError(t, err)
+	assert.NoError(t, vault.Store(key, "1234"))
+
+	// 파일에서 다시 불러온 저장소도 같은 비밀번호를 갖고 있어야함
+	vault, err = dc.NewFileVault(path)
+	assert.NoError(t, err)
+
+	password, ok := vault.Password(key)
+	assert.True(t, ok)
+	assert.Equal(t, "1234", password)
+
+	// 게시글 키와 댓글 키는 구분되어야함
+	_, ok = vault.Password(dc.VaultKey{Gallery: "test", Article: 1})
+	assert.False(t, ok)
+
+	assert.NoError(t, vault.Remove(key))
+
+	vault, err = dc.NewFileVault(path)
+	assert.NoError(t, err)
+
+	_, ok = vault.Password(key)
+	assert.False(t, ok)
+
+	// 게시글 키를 지우면 그 게시글에 달린 댓글 비밀번호도 함께 지워져야함
+	other := dc.VaultKey{Gallery: "test", Article: 10, Comment: 3}
+	assert.NoError(t, vault.Store(dc.VaultKey{Gallery: "test", Article: 1}, "1234"))
+	assert.NoError(t, vault.Store(key, "1234"))
+	assert.NoError(t, vault.Store(other, "1234"))
+	assert.NoError(t, vault.Remove(dc.VaultKey{Gallery: "test", Article: 1}))
+
+	_, ok = vault.Password(key)
+	assert.False(t, ok)
+
+	_, ok = vault.Password(other)
+	assert.True(t, ok)
+}